@@ -1,15 +1,17 @@
 package baseapp
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
 	"sort"
 	"strings"
-	"syscall"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -111,6 +113,14 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		))
 	}
 
+	// No further blocks are processed once the app has halted. The block is
+	// neither rejected nor committed, but held until the node is stopped by the
+	// listeners of Halted, so that it is processed as usual after a restart.
+	if app.halted {
+		app.logger.Error("cannot begin block; the node has halted per configuration", "height", req.Header.Height)
+		select {}
+	}
+
 	if err := app.validateHeight(req); err != nil {
 		panic(err)
 	}
@@ -138,11 +148,23 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	app.deliverState.ctx = app.deliverState.ctx.WithBlockGasMeter(gasMeter)
 
+	if app.blockGasReportEnabled {
+		app.blockGasReport = newBlockGasReport()
+	}
+
 	if app.beginBlocker != nil {
 		res = app.beginBlocker(app.deliverState.ctx, req)
 	}
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
+
+	// call the hooks with the BeginBlock messages
+	for _, streamingListener := range app.abciListeners {
+		if err := streamingListener.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+		}
+	}
+
 	return res
 }
 
@@ -158,6 +180,19 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res = app.endBlocker(app.deliverState.ctx, req)
 	}
 
+	if app.blockGasReport != nil {
+		gasUsed := app.deliverState.ctx.BlockGasMeter().GasConsumed()
+		res.Events = append(res.Events, app.blockGasReport.events(gasUsed).ToABCIEvents()...)
+		app.blockGasReport.emitTelemetry(gasUsed)
+	}
+
+	// call the streaming service hooks with the EndBlock messages
+	for _, streamingListener := range app.abciListeners {
+		if err := streamingListener.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+		}
+	}
+
 	return
 }
 
@@ -175,20 +210,28 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		return sdkerrors.ResponseCheckTx(err, 0, 0, app.trace)
 	}
 
-	var mode runTxMode
+	mode := checkTxMode(req)
+	gInfo, result, err := app.runTx(mode, req.Tx, tx)
+
+	return app.checkTxResponse(gInfo, result, err)
+}
 
+// checkTxMode returns the execution mode of a CheckTx request.
+func checkTxMode(req abci.RequestCheckTx) runTxMode {
 	switch {
 	case req.Type == abci.CheckTxType_New:
-		mode = runTxModeCheck
+		return runTxModeCheck
 
 	case req.Type == abci.CheckTxType_Recheck:
-		mode = runTxModeReCheck
+		return runTxModeReCheck
 
 	default:
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
+}
 
-	gInfo, result, err := app.runTx(mode, req.Tx, tx)
+// checkTxResponse builds the CheckTx response for the outcome of runTx.
+func (app *BaseApp) checkTxResponse(gInfo sdk.GasInfo, result *sdk.Result, err error) abci.ResponseCheckTx {
 	if err != nil {
 		return sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
 	}
@@ -207,9 +250,18 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
 	defer telemetry.MeasureSince("abci", "deliver_tx")
 
+	defer func() {
+		// call the streaming service hooks with the DeliverTx messages
+		for _, streamingListener := range app.abciListeners {
+			if err := streamingListener.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
+				app.logger.Error("DeliverTx listening hook failed", "err", err)
+			}
+		}
+	}()
+
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, 0, 0, app.trace)
@@ -265,6 +317,10 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// Commit. Use the header from this latest block.
 	app.setCheckState(header)
 
+	if app.queryPool != nil {
+		app.addQuerySnapshot(commitID.Version, header)
+	}
+
 	// empty/reset the deliver state
 	app.deliverState = nil
 
@@ -279,11 +335,15 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	}
 
 	if halt {
-		// Halt the binary and allow Tendermint to receive the ResponseCommit
+		// Halt the node and allow Tendermint to receive the ResponseCommit
 		// response with the commit ID hash. This will allow the node to successfully
 		// restart and process blocks assuming the halt configuration has been
 		// reset or moved to a more distant value.
-		app.halt()
+		app.halt(header.Height)
+	}
+
+	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
+		go app.snapshot(header.Height)
 	}
 
 	return abci.ResponseCommit{
@@ -291,26 +351,156 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	}
 }
 
-// halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
-// back on os.Exit if both fail.
-func (app *BaseApp) halt() {
-	app.logger.Info("halting node per configuration", "height", app.haltHeight, "time", app.haltTime)
+// halt marks the app as halted at the given, committed height and notifies the
+// listeners of Halted, which are responsible for stopping the node. BeginBlock
+// blocks once the app has halted, so that no further blocks are processed.
+func (app *BaseApp) halt(height int64) {
+	if app.halted {
+		return
+	}
+
+	app.logger.Info("halting node per configuration", "height", height, "halt-height", app.haltHeight, "halt-time", app.haltTime)
+
+	app.halted = true
+	close(app.haltCh)
+}
+
+// Halted returns a channel which is closed once the app has committed the block
+// at which it is configured to halt, per its halt height or halt time. The
+// caller is then expected to stop the node gracefully, as the app will not
+// process any further block.
+func (app *BaseApp) Halted() <-chan struct{} {
+	return app.haltCh
+}
+
+// snapshot takes a snapshot of the current state and prunes any old snapshots.
+func (app *BaseApp) snapshot(height int64) {
+	if app.snapshotManager == nil {
+		app.logger.Info("snapshot manager not configured")
+		return
+	}
+
+	app.logger.Info("creating state snapshot", "height", height)
+
+	snapshot, err := app.snapshotManager.Create(uint64(height))
+	if err != nil {
+		app.logger.Error("failed to create state snapshot", "height", height, "err", err)
+		return
+	}
+
+	app.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
 
-	p, err := os.FindProcess(os.Getpid())
-	if err == nil {
-		// attempt cascading signals in case SIGINT fails (os dependent)
-		sigIntErr := p.Signal(syscall.SIGINT)
-		sigTermErr := p.Signal(syscall.SIGTERM)
+	if app.snapshotKeepRecent > 0 {
+		app.logger.Debug("pruning state snapshots")
 
-		if sigIntErr == nil || sigTermErr == nil {
+		pruned, err := app.snapshotManager.Prune(app.snapshotKeepRecent)
+		if err != nil {
+			app.logger.Error("failed to prune state snapshots", "err", err)
 			return
 		}
+
+		app.logger.Debug("pruned state snapshots", "pruned", pruned)
+	}
+}
+
+// ListSnapshots mirrors the ABCI state sync method of the same name. It returns
+// the snapshots held by the snapshot manager, if any.
+func (app *BaseApp) ListSnapshots(req snapshottypes.RequestListSnapshots) snapshottypes.ResponseListSnapshots {
+	resp := snapshottypes.ResponseListSnapshots{Snapshots: []*snapshottypes.Snapshot{}}
+	if app.snapshotManager == nil {
+		return resp
 	}
 
-	// Resort to exiting immediately if the process could not be found or killed
-	// via SIGINT/SIGTERM signals.
-	app.logger.Info("failed to send SIGINT/SIGTERM; exiting...")
-	os.Exit(0)
+	snapshots, err := app.snapshotManager.List()
+	if err != nil {
+		app.logger.Error("failed to list snapshots", "err", err)
+		return resp
+	}
+
+	resp.Snapshots = snapshots
+
+	return resp
+}
+
+// LoadSnapshotChunk mirrors the ABCI state sync method of the same name. It
+// returns a chunk of a locally held snapshot.
+func (app *BaseApp) LoadSnapshotChunk(req snapshottypes.RequestLoadSnapshotChunk) snapshottypes.ResponseLoadSnapshotChunk {
+	if app.snapshotManager == nil {
+		return snapshottypes.ResponseLoadSnapshotChunk{}
+	}
+
+	chunk, err := app.snapshotManager.LoadChunk(req.Height, req.Format, req.Chunk)
+	if err != nil {
+		app.logger.Error("failed to load snapshot chunk", "height", req.Height, "format", req.Format,
+			"chunk", req.Chunk, "err", err)
+		return snapshottypes.ResponseLoadSnapshotChunk{}
+	}
+
+	return snapshottypes.ResponseLoadSnapshotChunk{Chunk: chunk}
+}
+
+// OfferSnapshot mirrors the ABCI state sync method of the same name. It starts
+// restoring the offered snapshot if its format is supported.
+func (app *BaseApp) OfferSnapshot(req snapshottypes.RequestOfferSnapshot) snapshottypes.ResponseOfferSnapshot {
+	if app.snapshotManager == nil {
+		app.logger.Error("snapshot manager not configured")
+		return snapshottypes.ResponseOfferSnapshot{Result: snapshottypes.OfferSnapshotAbort}
+	}
+
+	if req.Snapshot == nil {
+		app.logger.Error("received nil snapshot")
+		return snapshottypes.ResponseOfferSnapshot{Result: snapshottypes.OfferSnapshotReject}
+	}
+
+	err := app.snapshotManager.Restore(*req.Snapshot)
+	switch {
+	case err == nil:
+		return snapshottypes.ResponseOfferSnapshot{Result: snapshottypes.OfferSnapshotAccept}
+
+	case errors.Is(err, snapshottypes.ErrUnknownFormat):
+		return snapshottypes.ResponseOfferSnapshot{Result: snapshottypes.OfferSnapshotRejectFormat}
+
+	case errors.Is(err, snapshottypes.ErrInvalidMetadata):
+		app.logger.Error("rejecting invalid snapshot", "height", req.Snapshot.Height,
+			"format", req.Snapshot.Format, "err", err)
+		return snapshottypes.ResponseOfferSnapshot{Result: snapshottypes.OfferSnapshotReject}
+
+	default:
+		app.logger.Error("failed to restore snapshot", "height", req.Snapshot.Height,
+			"format", req.Snapshot.Format, "err", err)
+
+		// We currently don't support resetting the IAVL stores and retrying a different snapshot,
+		// so we ask Tendermint to abort all snapshot restoration.
+		return snapshottypes.ResponseOfferSnapshot{Result: snapshottypes.OfferSnapshotAbort}
+	}
+}
+
+// ApplySnapshotChunk mirrors the ABCI state sync method of the same name. It
+// feeds a chunk to the snapshot restoration started by OfferSnapshot.
+func (app *BaseApp) ApplySnapshotChunk(req snapshottypes.RequestApplySnapshotChunk) snapshottypes.ResponseApplySnapshotChunk {
+	if app.snapshotManager == nil {
+		app.logger.Error("snapshot manager not configured")
+		return snapshottypes.ResponseApplySnapshotChunk{Result: snapshottypes.ApplySnapshotChunkAbort}
+	}
+
+	_, err := app.snapshotManager.RestoreChunk(req.Chunk)
+	switch {
+	case err == nil:
+		return snapshottypes.ResponseApplySnapshotChunk{Result: snapshottypes.ApplySnapshotChunkAccept}
+
+	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
+		app.logger.Error("chunk checksum mismatch; rejecting sender and requesting refetch",
+			"chunk", req.Index, "sender", req.Sender, "err", err)
+		return snapshottypes.ResponseApplySnapshotChunk{
+			Result:        snapshottypes.ApplySnapshotChunkRetry,
+			RefetchChunks: []uint32{req.Index},
+			RejectSenders: []string{req.Sender},
+		}
+
+	default:
+		app.logger.Error("failed to restore snapshot", "err", err)
+		return snapshottypes.ResponseApplySnapshotChunk{Result: snapshottypes.ApplySnapshotChunkAbort}
+	}
 }
 
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
@@ -348,10 +538,14 @@ func (app *BaseApp) Query(req abci.RequestQuery) abci.ResponseQuery {
 }
 
 func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
-	ctx, err := app.createQueryContext(req)
+	ctx, release, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
+	defer release()
+
+	// respond with the height the query was served at
+	req.Height = ctx.BlockHeight()
 
 	res, err := handler(ctx, req)
 	if err != nil {
@@ -363,35 +557,101 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQu
 	return res
 }
 
-func (app *BaseApp) createQueryContext(req abci.RequestQuery) (sdk.Context, error) {
+// addQuerySnapshot adds a snapshot of the given committed version to the query
+// pool. The snapshots of pruned versions are evicted before pruning them.
+func (app *BaseApp) addQuerySnapshot(version int64, header abci.Header) {
+	rms := app.cms.(*rootmulti.Store)
+
+	store, err := rms.ReadOnlyVersion(version)
+	if err != nil {
+		app.logger.Error("failed to snapshot committed version for queries", "version", version, "err", err)
+		return
+	}
+
+	app.queryPool.add(newQuerySnapshot(store, header))
+}
+
+// createQueryContext creates a new sdk.Context for a query, on the state at the
+// given height, or at the latest height if it is 0. The block height of the
+// context is the height of the state. The returned function must be called once
+// the query is done reading the state, so that its version may be pruned.
+func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, func(), error) {
+	if height < 0 {
+		return sdk.Context{}, nil,
+			sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "cannot query with negative height %d", height)
+	}
+
+	var (
+		latest          querySnapshot
+		hasSnapshot     bool
+		lastBlockHeight int64
+	)
+
+	// queries are served from the latest snapshot of the pool if there is one,
+	// as reading the latest height of the multi-store would race with Commit
+	if app.queryPool != nil {
+		latest, hasSnapshot = app.queryPool.latest()
+	}
+
+	if hasSnapshot {
+		lastBlockHeight = latest.store.Version()
+	} else {
+		lastBlockHeight = app.LastBlockHeight()
+	}
+
 	// when a client did not provide a query height, manually inject the latest
-	if req.Height == 0 {
-		req.Height = app.LastBlockHeight()
+	if height == 0 {
+		height = lastBlockHeight
 	}
 
-	if req.Height <= 1 && req.Prove {
-		return sdk.Context{},
+	if height > lastBlockHeight {
+		return sdk.Context{}, nil,
+			sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidHeight,
+				"cannot query with height in the future; height: %d, latest height: %d", height, lastBlockHeight,
+			)
+	}
+
+	if height <= 1 && prove {
+		return sdk.Context{}, nil,
 			sdkerrors.Wrap(
 				sdkerrors.ErrInvalidRequest,
 				"cannot query with proof when height <= 1; please provide a valid height",
 			)
 	}
 
-	cacheMS, err := app.cms.CacheMultiStoreWithVersion(req.Height)
+	if hasSnapshot {
+		if snapshot, release, ok := app.queryPool.acquire(height); ok {
+			ctx := sdk.NewContext(
+				snapshot.store.CacheMultiStore(), snapshot.header, true, app.logger,
+			).WithMinGasPrices(app.minGasPrices).WithBlockHeight(height)
+
+			return ctx, release, nil
+		}
+	}
+
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
 	if err != nil {
-		return sdk.Context{},
+		return sdk.Context{}, nil,
 			sdkerrors.Wrapf(
-				sdkerrors.ErrInvalidRequest,
-				"failed to load state at height %d; %s (latest height: %d)", req.Height, err, app.LastBlockHeight(),
+				sdkerrors.ErrInvalidHeight,
+				"state at height %d is not available, it may have been pruned (latest height: %d): %s", height, lastBlockHeight, err,
 			)
 	}
 
+	// the headers of the blocks prior to the latest are not stored, so the header
+	// of a historical query is left empty apart from its height
+	var header abci.Header
+	if height == lastBlockHeight {
+		header = app.checkState.ctx.BlockHeader()
+	}
+
 	// cache wrap the commit-multistore for safety
 	ctx := sdk.NewContext(
-		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
+		cacheMS, header, true, app.logger,
+	).WithMinGasPrices(app.minGasPrices).WithBlockHeight(height)
 
-	return ctx, nil
+	return ctx, func() {}, nil
 }
 
 func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.ResponseQuery {
@@ -433,6 +693,25 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 				Value:     []byte(app.appVersion),
 			}
 
+		case "store-profile":
+			if app.storeProfiler == nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "store profiling is disabled"))
+			}
+
+			report := app.storeProfiler.Report()
+			report.Height = app.LastBlockHeight()
+
+			bz, err := json.Marshal(report)
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to JSON encode store profile"))
+			}
+
+			return abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    report.Height,
+				Value:     bz,
+			}
+
 		default:
 			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path))
 		}
@@ -516,10 +795,11 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no custom querier found for route %s", path[1]))
 	}
 
-	ctx, err := app.createQueryContext(req)
+	ctx, release, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
+	defer release()
 
 	// Passes the rest of the path as an argument to the querier.
 	//
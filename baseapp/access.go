@@ -0,0 +1,345 @@
+package baseapp
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.MultiStore      = (*accessMultiStore)(nil)
+	_ sdk.CacheMultiStore = (*txCacheMultiStore)(nil)
+	_ sdk.KVStore         = (*accessKVStore)(nil)
+)
+
+// storeWrite is a single write made by a transaction.
+type storeWrite struct {
+	storeKey sdk.StoreKey
+	key      []byte
+	value    []byte
+	delete   bool
+}
+
+// keyRange is a range of keys read through an iterator.
+type keyRange struct {
+	storeKey   sdk.StoreKey
+	start, end []byte
+}
+
+// contains returns true if the key lies within the range.
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) &&
+		(r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// accessLog records the state accessed by a single transaction: the keys it
+// read, the key ranges it iterated over and the writes it made, in order.
+type accessLog struct {
+	reads  map[sdk.StoreKey]map[string]struct{}
+	ranges []keyRange
+	writes []storeWrite
+}
+
+func newAccessLog() *accessLog {
+	return &accessLog{reads: make(map[sdk.StoreKey]map[string]struct{})}
+}
+
+func (l *accessLog) read(storeKey sdk.StoreKey, key []byte) {
+	keys, ok := l.reads[storeKey]
+	if !ok {
+		keys = make(map[string]struct{})
+		l.reads[storeKey] = keys
+	}
+
+	keys[string(key)] = struct{}{}
+}
+
+func (l *accessLog) iterate(storeKey sdk.StoreKey, start, end []byte) {
+	l.ranges = append(l.ranges, keyRange{
+		storeKey: storeKey,
+		start:    append([]byte(nil), start...),
+		end:      append([]byte(nil), end...),
+	})
+}
+
+func (l *accessLog) write(storeKey sdk.StoreKey, key, value []byte, delete bool) {
+	w := storeWrite{
+		storeKey: storeKey,
+		key:      append([]byte(nil), key...),
+		delete:   delete,
+	}
+
+	if !delete {
+		// values may be empty but never nil
+		w.value = make([]byte, len(value))
+		copy(w.value, value)
+	}
+
+	l.writes = append(l.writes, w)
+}
+
+// accessMultiStore wraps a MultiStore and records every access made to its
+// KVStores, either directly or by writing a cache-wrapped MultiStore derived
+// from it, into the current access log.
+type accessMultiStore struct {
+	parent sdk.MultiStore
+	log    *accessLog
+}
+
+func newAccessMultiStore(parent sdk.MultiStore) *accessMultiStore {
+	return &accessMultiStore{parent: parent, log: newAccessLog()}
+}
+
+// GetStoreType implements the MultiStore interface.
+func (ms *accessMultiStore) GetStoreType() sdk.StoreType {
+	return ms.parent.GetStoreType()
+}
+
+// CacheWrap implements the MultiStore interface.
+func (ms *accessMultiStore) CacheWrap() sdk.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements the MultiStore interface. Tracing is not
+// supported, so it is equivalent to CacheWrap.
+func (ms *accessMultiStore) CacheWrapWithTrace(_ io.Writer, _ sdk.TraceContext) sdk.CacheWrap {
+	return ms.CacheWrap()
+}
+
+// CacheMultiStore implements the MultiStore interface.
+func (ms *accessMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	return newTxCacheMultiStore(ms)
+}
+
+// CacheMultiStoreWithVersion implements the MultiStore interface. It will panic
+// since previous versions are not accessible through a wrapped MultiStore.
+func (ms *accessMultiStore) CacheMultiStoreWithVersion(_ int64) (sdk.CacheMultiStore, error) {
+	panic("cannot cache-wrap an access recording multi-store with a version")
+}
+
+// GetStore implements the MultiStore interface.
+func (ms *accessMultiStore) GetStore(key sdk.StoreKey) sdk.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore implements the MultiStore interface. The returned KVStore records
+// its accesses into the access log of the MultiStore at the time of access.
+func (ms *accessMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	return &accessKVStore{parent: ms.parent.GetKVStore(key), storeKey: key, ms: ms}
+}
+
+// TracingEnabled implements the MultiStore interface.
+func (ms *accessMultiStore) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements the MultiStore interface. Tracing is not supported.
+func (ms *accessMultiStore) SetTracer(_ io.Writer) sdk.MultiStore {
+	return ms
+}
+
+// SetTracingContext implements the MultiStore interface. Tracing is not
+// supported.
+func (ms *accessMultiStore) SetTracingContext(_ sdk.TraceContext) sdk.MultiStore {
+	return ms
+}
+
+// ListeningEnabled implements the MultiStore interface.
+func (ms *accessMultiStore) ListeningEnabled(_ sdk.StoreKey) bool {
+	return false
+}
+
+// AddListeners implements the MultiStore interface. It will panic since
+// listening is not supported.
+func (ms *accessMultiStore) AddListeners(_ sdk.StoreKey, _ []sdk.WriteListener) {
+	panic("cannot add listeners to an access recording multi-store")
+}
+
+// txCacheMultiStore is a CacheMultiStore which cache-wraps the KVStores of its
+// parent on first use, so that it does not need to know the store keys up
+// front.
+type txCacheMultiStore struct {
+	parent sdk.MultiStore
+	stores map[sdk.StoreKey]sdk.CacheWrap
+}
+
+func newTxCacheMultiStore(parent sdk.MultiStore) *txCacheMultiStore {
+	return &txCacheMultiStore{parent: parent, stores: make(map[sdk.StoreKey]sdk.CacheWrap)}
+}
+
+// GetStoreType implements the MultiStore interface.
+func (ms *txCacheMultiStore) GetStoreType() sdk.StoreType {
+	return sdk.StoreTypeMulti
+}
+
+// CacheWrap implements the MultiStore interface.
+func (ms *txCacheMultiStore) CacheWrap() sdk.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements the MultiStore interface. Tracing is not
+// supported, so it is equivalent to CacheWrap.
+func (ms *txCacheMultiStore) CacheWrapWithTrace(_ io.Writer, _ sdk.TraceContext) sdk.CacheWrap {
+	return ms.CacheWrap()
+}
+
+// CacheMultiStore implements the MultiStore interface.
+func (ms *txCacheMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	return newTxCacheMultiStore(ms)
+}
+
+// CacheMultiStoreWithVersion implements the MultiStore interface. It will panic
+// as an already cached multi-store cannot load previous versions.
+func (ms *txCacheMultiStore) CacheMultiStoreWithVersion(_ int64) (sdk.CacheMultiStore, error) {
+	panic("cannot cache-wrap cached multi-store with a version")
+}
+
+// GetStore implements the MultiStore interface.
+func (ms *txCacheMultiStore) GetStore(key sdk.StoreKey) sdk.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore implements the MultiStore interface.
+func (ms *txCacheMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	store, ok := ms.stores[key]
+	if !ok {
+		store = ms.parent.GetKVStore(key).CacheWrap()
+		ms.stores[key] = store
+	}
+
+	return store.(sdk.KVStore)
+}
+
+// TracingEnabled implements the MultiStore interface.
+func (ms *txCacheMultiStore) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements the MultiStore interface. Tracing is not supported.
+func (ms *txCacheMultiStore) SetTracer(_ io.Writer) sdk.MultiStore {
+	return ms
+}
+
+// SetTracingContext implements the MultiStore interface. Tracing is not
+// supported.
+func (ms *txCacheMultiStore) SetTracingContext(_ sdk.TraceContext) sdk.MultiStore {
+	return ms
+}
+
+// ListeningEnabled implements the MultiStore interface.
+func (ms *txCacheMultiStore) ListeningEnabled(_ sdk.StoreKey) bool {
+	return false
+}
+
+// AddListeners implements the MultiStore interface. It will panic since
+// listening is not supported.
+func (ms *txCacheMultiStore) AddListeners(_ sdk.StoreKey, _ []sdk.WriteListener) {
+	panic("cannot add listeners to a transaction cache multi-store")
+}
+
+// Write implements the CacheMultiStore interface. It writes the cached
+// KVStores to the parent.
+func (ms *txCacheMultiStore) Write() {
+	for _, store := range ms.stores {
+		store.Write()
+	}
+}
+
+// accessKVStore is a KVStore which records its accesses into the current
+// access log of its accessMultiStore.
+type accessKVStore struct {
+	parent   sdk.KVStore
+	storeKey sdk.StoreKey
+	ms       *accessMultiStore
+}
+
+// GetStoreType implements the KVStore interface.
+func (s *accessKVStore) GetStoreType() sdk.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Get implements the KVStore interface.
+func (s *accessKVStore) Get(key []byte) []byte {
+	s.ms.log.read(s.storeKey, key)
+	return s.parent.Get(key)
+}
+
+// Has implements the KVStore interface.
+func (s *accessKVStore) Has(key []byte) bool {
+	s.ms.log.read(s.storeKey, key)
+	return s.parent.Has(key)
+}
+
+// Set implements the KVStore interface.
+func (s *accessKVStore) Set(key, value []byte) {
+	s.parent.Set(key, value)
+	s.ms.log.write(s.storeKey, key, value, false)
+}
+
+// Delete implements the KVStore interface.
+func (s *accessKVStore) Delete(key []byte) {
+	s.parent.Delete(key)
+	s.ms.log.write(s.storeKey, key, nil, true)
+}
+
+// Iterator implements the KVStore interface.
+func (s *accessKVStore) Iterator(start, end []byte) sdk.Iterator {
+	s.ms.log.iterate(s.storeKey, start, end)
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface.
+func (s *accessKVStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	s.ms.log.iterate(s.storeKey, start, end)
+	return s.parent.ReverseIterator(start, end)
+}
+
+// CacheWrap implements the KVStore interface.
+func (s *accessKVStore) CacheWrap() sdk.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *accessKVStore) CacheWrapWithTrace(w io.Writer, tc sdk.TraceContext) sdk.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// conflicts returns true if the transaction read a key, or iterated over a range
+// containing a key, which was last written by a transaction of a lane other than
+// the given one.
+func (l *accessLog) conflicts(lastWriters map[sdk.StoreKey]map[string]int, lane int) bool {
+	for storeKey, keys := range l.reads {
+		writers := lastWriters[storeKey]
+		for key := range keys {
+			if writer, ok := writers[key]; ok && writer != lane {
+				return true
+			}
+		}
+	}
+
+	for _, r := range l.ranges {
+		for key, writer := range lastWriters[r.storeKey] {
+			if writer != lane && r.contains([]byte(key)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// apply applies the writes of the transaction to the given MultiStore in the
+// order they were made.
+func (l *accessLog) apply(ms sdk.MultiStore) {
+	for _, w := range l.writes {
+		store := ms.GetKVStore(w.storeKey)
+		if w.delete {
+			store.Delete(w.key)
+		} else {
+			store.Set(w.key, w.value)
+		}
+	}
+}
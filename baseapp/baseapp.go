@@ -1,8 +1,10 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/gogo/protobuf/proto"
@@ -12,7 +14,10 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/profilekv"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -42,17 +47,19 @@ type (
 // BaseApp reflects the ABCI application implementation.
 type BaseApp struct { // nolint: maligned
 	// initialized on creation
-	logger          log.Logger
-	name            string               // application name from abci.Info
-	db              dbm.DB               // common DB backend
-	cms             sdk.CommitMultiStore // Main (uncached) state
-	storeLoader     StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
-	router          sdk.Router           // handle any kind of message
-	queryRouter     sdk.QueryRouter      // router for redirecting query calls
-	grpcQueryRouter *GRPCQueryRouter     // router for redirecting gRPC query calls
-	txDecoder       sdk.TxDecoder        // unmarshal []byte into sdk.Tx
+	logger           log.Logger
+	name             string               // application name from abci.Info
+	db               dbm.DB               // common DB backend
+	cms              sdk.CommitMultiStore // Main (uncached) state
+	storeLoader      StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
+	router           sdk.Router           // handle any kind of message
+	queryRouter      sdk.QueryRouter      // router for redirecting query calls
+	grpcQueryRouter  *GRPCQueryRouter     // router for redirecting gRPC query calls
+	msgServiceRouter *MsgServiceRouter    // router for redirecting Msg service messages
+	txDecoder        sdk.TxDecoder        // unmarshal []byte into sdk.Tx
 
 	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
+	postHandler    sdk.PostHandler  // post handler, e.g. for gas refunds
 	initChainer    sdk.InitChainer  // initialize state with validators and state blob
 	beginBlocker   sdk.BeginBlocker // logic to run before any txs
 	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
@@ -60,6 +67,11 @@ type BaseApp struct { // nolint: maligned
 	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
+	// manages snapshots, i.e. dumps of app state at certain intervals
+	snapshotManager    *snapshots.Manager
+	snapshotInterval   uint64 // block interval between state sync snapshots
+	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+
 	// volatile states:
 	//
 	// checkState is set on InitChain and reset on Commit
@@ -70,6 +82,22 @@ type BaseApp struct { // nolint: maligned
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache sdk.MultiStorePersistentCache
 
+	// records the accesses made to the stores, reported by the store-profile
+	// query; nil if the stores are not profiled
+	storeProfiler *profilekv.Profiler
+
+	// read-only snapshots of the most recent committed versions queries are
+	// served from, nil if queries are served from the multi-store directly
+	queryPool *queryPool
+
+	// number of workers running transactions concurrently in CheckTxBatch
+	checkTxWorkers int
+
+	// if true, the gas consumed in each block is reported in EndBlock
+	blockGasReportEnabled bool
+	// gas consumed in the current block by message type and store key, reset on BeginBlock
+	blockGasReport *blockGasReport
+
 	// absent validators from begin block
 	voteInfos []abci.VoteInfo
 
@@ -90,6 +118,10 @@ type BaseApp struct { // nolint: maligned
 	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
 	haltTime uint64
 
+	// closed once the block at which to halt has been committed
+	haltCh chan struct{}
+	halted bool
+
 	// application's version string
 	appVersion string
 
@@ -98,6 +130,11 @@ type BaseApp struct { // nolint: maligned
 
 	// trace set will return full stack traces for errors in ABCI Log field
 	trace bool
+
+	// abciListeners are notified of every BeginBlock, DeliverTx and EndBlock,
+	// streamingListeners of every write to the DeliverTx state
+	abciListeners      []ABCIListener
+	streamingListeners map[sdk.StoreKey][]sdk.WriteListener
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -109,16 +146,18 @@ func NewBaseApp(
 	name string, logger log.Logger, db dbm.DB, txDecoder sdk.TxDecoder, options ...func(*BaseApp),
 ) *BaseApp {
 	app := &BaseApp{
-		logger:          logger,
-		name:            name,
-		db:              db,
-		cms:             store.NewCommitMultiStore(db),
-		storeLoader:     DefaultStoreLoader,
-		router:          NewRouter(),
-		queryRouter:     NewQueryRouter(),
-		grpcQueryRouter: NewGRPCQueryRouter(),
-		txDecoder:       txDecoder,
-		fauxMerkleMode:  false,
+		logger:           logger,
+		name:             name,
+		db:               db,
+		cms:              store.NewCommitMultiStore(db),
+		storeLoader:      DefaultStoreLoader,
+		router:           NewRouter(),
+		queryRouter:      NewQueryRouter(),
+		grpcQueryRouter:  NewGRPCQueryRouter(),
+		msgServiceRouter: NewMsgServiceRouter(),
+		txDecoder:        txDecoder,
+		fauxMerkleMode:   false,
+		haltCh:           make(chan struct{}),
 	}
 
 	for _, option := range options {
@@ -260,6 +299,21 @@ func (app *BaseApp) init() error {
 	app.setCheckState(abci.Header{})
 	app.Seal()
 
+	// make sure the snapshot interval is a multiple of the pruning KeepEvery interval
+	if app.snapshotManager != nil && app.snapshotInterval > 0 {
+		rms, ok := app.cms.(*rootmulti.Store)
+		if !ok {
+			return errors.New("state sync snapshots require a rootmulti store")
+		}
+
+		pruningOpts := rms.GetPruning()
+		if pruningOpts.KeepEvery > 0 && app.snapshotInterval%pruningOpts.KeepEvery != 0 {
+			return fmt.Errorf(
+				"state sync snapshot interval %v must be a multiple of pruning keep every interval %v",
+				app.snapshotInterval, pruningOpts.KeepEvery)
+		}
+	}
+
 	return nil
 }
 
@@ -279,6 +333,34 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setStoreProfiler(profiler *profilekv.Profiler) {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic("store profiling requires a rootmulti store")
+	}
+
+	rms.SetProfiler(profiler)
+	app.storeProfiler = profiler
+}
+
+func (app *BaseApp) setQuerySnapshots(snapshots int) {
+	if snapshots <= 0 {
+		app.queryPool = nil
+		return
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic("query snapshots require a rootmulti store")
+	}
+
+	app.queryPool = newQueryPool(snapshots)
+
+	// the snapshots of the versions being pruned are evicted before they are
+	// deleted, and those still being read are pruned later
+	rms.SetBeforePrune(app.queryPool.evict)
+}
+
 func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
@@ -300,6 +382,9 @@ func (app *BaseApp) QueryRouter() sdk.QueryRouter { return app.queryRouter }
 // GRPCQueryRouter returns the GRPCQueryRouter of a BaseApp.
 func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRouter }
 
+// MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
+func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgServiceRouter }
+
 // Seal seals a BaseApp. It prohibits any further modifications to a BaseApp.
 func (app *BaseApp) Seal() { app.sealed = true }
 
@@ -324,6 +409,10 @@ func (app *BaseApp) setCheckState(header abci.Header) {
 // Commit.
 func (app *BaseApp) setDeliverState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
+	for key, listeners := range app.streamingListeners {
+		ms.AddListeners(key, listeners)
+	}
+
 	app.deliverState = &state{
 		ms:  ms,
 		ctx: sdk.NewContext(ms, header, false, app.logger),
@@ -459,6 +548,10 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context
 		ctx = ctx.WithIsReCheckTx(true)
 	}
 
+	if mode == runTxModeDeliver && app.blockGasReport != nil {
+		ctx = ctx.WithGasTracker(app.blockGasReport)
+	}
+
 	if mode == runTxModeSimulate {
 		ctx, _ = ctx.CacheContext()
 	}
@@ -493,12 +586,19 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, tx)
+}
+
+// runTxWithContext processes a transaction like runTx, using the given Context
+// instead of the one of the state belonging to the execution mode.
+func (app *BaseApp) runTxWithContext(
+	ctx sdk.Context, mode runTxMode, txBytes []byte, tx sdk.Tx,
+) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -538,9 +638,13 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 		}
 	}()
 
+	// the messages were validated when the transaction was first checked, and
+	// their validity does not depend on state
 	msgs := tx.GetMsgs()
-	if err := validateBasicTxMsgs(msgs); err != nil {
-		return sdk.GasInfo{}, nil, err
+	if mode != runTxModeReCheck {
+		if err := validateBasicTxMsgs(msgs); err != nil {
+			return sdk.GasInfo{}, nil, err
+		}
 	}
 
 	var events sdk.Events
@@ -593,6 +697,31 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 	// and we're in DeliverTx. Note, runMsgs will never return a reference to a
 	// Result if any single message fails or does not have a registered Handler.
 	result, err = app.runMsgs(runMsgCtx, msgs, mode)
+
+	// The PostHandler runs whenever the messages were executed, on top of their
+	// state if they succeeded and on the state left by the AnteHandler
+	// otherwise. If it fails, the state changes of the messages are discarded as
+	// well.
+	if app.postHandler != nil && (mode == runTxModeDeliver || mode == runTxModeSimulate) {
+		success := err == nil
+
+		postCtx, postMsCache := runMsgCtx, msCache
+		if !success {
+			postCtx, postMsCache = app.cacheTxContext(ctx, txBytes)
+		}
+
+		postEvents, postErr := app.runPostHandler(postCtx, tx, mode, success, gasWanted)
+		if postErr != nil {
+			return gInfo, nil, postErr
+		}
+
+		if success {
+			result.Events = append(result.Events, postEvents.ToABCIEvents()...)
+		} else if mode == runTxModeDeliver {
+			postMsCache.Write()
+		}
+	}
+
 	if err == nil && mode == runTxModeDeliver {
 		msCache.Write()
 
@@ -602,9 +731,54 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 		}
 	}
 
+	if err == nil && (mode == runTxModeCheck || mode == runTxModeReCheck) {
+		// pass on the mempool priority and sender set by the AnteHandler
+		if event, ok := mempoolEvent(ctx); ok {
+			result.Events = append(result.Events, event)
+		}
+	}
+
 	return gInfo, result, err
 }
 
+// runPostHandler runs the PostHandler on the given Context, passing it whether
+// the messages of the transaction succeeded and the gas wanted and used so far.
+// It returns the events emitted by the PostHandler.
+func (app *BaseApp) runPostHandler(
+	ctx sdk.Context, tx sdk.Tx, mode runTxMode, success bool, gasWanted uint64,
+) (sdk.Events, error) {
+	postCtx := ctx.WithEventManager(sdk.NewEventManager())
+	gasUsed := postCtx.GasMeter().GasConsumed()
+
+	newCtx, err := app.postHandler(postCtx, tx, mode == runTxModeSimulate, success, gasWanted, gasUsed)
+	if err != nil {
+		return nil, err
+	}
+
+	if !newCtx.IsZero() {
+		postCtx = newCtx
+	}
+
+	return postCtx.EventManager().Events(), nil
+}
+
+// mempoolEvent returns an event holding the mempool priority and sender key of
+// the transaction, if any of them was set in the given Context. Tendermint does
+// not support them in ResponseCheckTx yet, so they are attached as an event.
+func mempoolEvent(ctx sdk.Context) (abci.Event, bool) {
+	if ctx.Priority() == 0 && ctx.Sender() == "" {
+		return abci.Event{}, false
+	}
+
+	event := sdk.NewEvent(
+		sdk.EventTypeMempool,
+		sdk.NewAttribute(sdk.AttributeKeyPriority, strconv.FormatInt(ctx.Priority(), 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, ctx.Sender()),
+	)
+
+	return abci.Event(event), true
+}
+
 // runMsgs iterates through a list of messages and executes them with the provided
 // Context and execution mode. Messages will only be executed during simulation
 // and DeliverTx. An error is returned if any single message fails or if a
@@ -624,14 +798,36 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			break
 		}
 
-		msgRoute := msg.Route()
-		handler := app.router.Route(ctx, msgRoute)
+		var (
+			msgResult *sdk.Result
+			err       error
+		)
+
+		gasBefore := ctx.GasMeter().GasConsumed()
+
+		if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+			// Msg service messages are routed by their fully-qualified method name
+			handler := app.msgServiceRouter.Handler(svcMsg.MethodName)
+			if handler == nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized Msg service method: %s; message index: %d", svcMsg.MethodName, i)
+			}
+
+			msgResult, err = handler(ctx, svcMsg.Request)
+		} else {
+			msgRoute := msg.Route()
+			handler := app.router.Route(ctx, msgRoute)
+
+			if handler == nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+			}
+
+			msgResult, err = handler(ctx, msg)
+		}
 
-		if handler == nil {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+		if mode == runTxModeDeliver && app.blockGasReport != nil {
+			app.blockGasReport.trackMsgGas(msg, ctx.GasMeter().GasConsumed()-gasBefore)
 		}
 
-		msgResult, err := handler(ctx, msg)
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
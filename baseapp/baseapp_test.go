@@ -5,10 +5,13 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"math/rand"
 	"os"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec/testdata"
 
@@ -20,6 +23,10 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/cache"
+	"github.com/cosmos/cosmos-sdk/store/profilekv"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -89,6 +96,8 @@ func registerTestCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&msgCounter{}, "cosmos-sdk/baseapp/msgCounter", nil)
 	cdc.RegisterConcrete(&msgCounter2{}, "cosmos-sdk/baseapp/msgCounter2", nil)
 	cdc.RegisterConcrete(&msgNoRoute{}, "cosmos-sdk/baseapp/msgNoRoute", nil)
+	cdc.RegisterConcrete(&msgKeyValue{}, "cosmos-sdk/baseapp/msgKeyValue", nil)
+	cdc.RegisterConcrete(&msgSigner{}, "cosmos-sdk/baseapp/msgSigner", nil)
 }
 
 // simple one store baseapp
@@ -273,6 +282,32 @@ func TestAppVersionSetterGetter(t *testing.T) {
 	require.Equal(t, versionString, string(res.Value))
 }
 
+func TestStoreProfileQuery(t *testing.T) {
+	// the query fails if the stores are not profiled
+	app := setupBaseApp(t)
+	res := app.Query(abci.RequestQuery{Path: "app/store-profile"})
+	require.False(t, res.IsOK())
+
+	app = setupBaseApp(t, SetStoreProfiler(profilekv.NewProfiler(profilekv.DefaultPrefixLength)))
+	app.InitChain(abci.RequestInitChain{})
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	app.deliverState.ctx.KVStore(capKey1).Set([]byte("key"), []byte("value"))
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	res = app.Query(abci.RequestQuery{Path: "app/store-profile"})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(1), res.Height)
+
+	var report profilekv.Report
+	require.NoError(t, json.Unmarshal(res.Value, &report))
+	require.Equal(t, int64(1), report.Height)
+	require.Len(t, report.Stores, 1)
+	require.Equal(t, capKey1.Name(), report.Stores[0].StoreKey)
+	require.Equal(t, uint64(1), report.Stores[0].Total.Writes)
+}
+
 func TestLoadVersionInvalid(t *testing.T) {
 	logger := log.NewNopLogger()
 	pruningOpt := SetPruning(store.PruneNothing)
@@ -399,6 +434,90 @@ func TestTxDecoder(t *testing.T) {
 }
 
 // Test that Info returns the latest committed state.
+// Test that the writes made through the inter-block cache are committed with
+// their block, so that the app hash is the same as without the cache.
+func TestInterBlockCacheCommit(t *testing.T) {
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	newApp := func(db dbm.DB, options ...func(*BaseApp)) *BaseApp {
+		options = append(options, func(bapp *BaseApp) {
+			bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+				kv := msg.(*msgKeyValue)
+				if kv.Value == nil {
+					ctx.KVStore(capKey2).Delete(kv.Key)
+				} else {
+					ctx.KVStore(capKey2).Set(kv.Key, kv.Value)
+				}
+				return &sdk.Result{}, nil
+			}))
+		})
+
+		app := NewBaseApp(t.Name(), defaultLogger(), db, testTxDecoder(codec), options...)
+		app.MountStores(capKey1, capKey2)
+		app.SetParamStore(&paramStore{db: dbm.NewMemDB()})
+		require.NoError(t, app.LoadLatestVersion())
+
+		return app
+	}
+
+	testCases := []struct {
+		name  string
+		cache sdk.MultiStorePersistentCache
+	}{
+		{"write-through", cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)},
+		{"write-back", cache.NewWriteBackCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			app := newApp(db, SetInterBlockCache(tc.cache))
+			refApp := newApp(dbm.NewMemDB())
+
+			app.InitChain(abci.RequestInitChain{})
+			refApp.InitChain(abci.RequestInitChain{})
+
+			for height := int64(1); height <= 5; height++ {
+				// the same keys are rewritten every block, and some of them deleted
+				tx := txTest{Msgs: []sdk.Msg{}}
+				for i := 0; i < 10; i++ {
+					msg := &msgKeyValue{Key: []byte(fmt.Sprintf("key%d", i)), Value: []byte(fmt.Sprintf("value%d", height))}
+					if (int64(i)+height)%4 == 0 {
+						msg.Value = nil
+					}
+					tx.Msgs = append(tx.Msgs, msg)
+				}
+
+				txBytes, err := codec.MarshalBinaryBare(tx)
+				require.NoError(t, err)
+
+				for _, a := range []*BaseApp{app, refApp} {
+					a.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+					res := a.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+					require.True(t, res.IsOK(), "%v", res.String())
+					a.EndBlock(abci.RequestEndBlock{Height: height})
+				}
+
+				require.Equal(t, refApp.Commit().Data, app.Commit().Data)
+				require.Equal(t, refApp.LastCommitID(), app.LastCommitID())
+
+				// the committed store holds the writes of the block
+				committed := newApp(db)
+				require.Equal(t, app.LastCommitID(), committed.LastCommitID())
+
+				for i := 0; i < 10; i++ {
+					key := []byte(fmt.Sprintf("key%d", i))
+					require.Equal(t, refApp.cms.GetKVStore(capKey2).Get(key), committed.cms.GetKVStore(capKey2).Get(key))
+					require.Equal(t, committed.cms.GetKVStore(capKey2).Get(key), app.cms.GetKVStore(capKey2).Get(key))
+				}
+			}
+		})
+	}
+}
+
 func TestInfo(t *testing.T) {
 	app := newBaseApp(t.Name())
 
@@ -540,6 +659,7 @@ type txTest struct {
 	Msgs       []sdk.Msg
 	Counter    int64
 	FailOnAnte bool
+	FailOnPost bool
 }
 
 func (tx *txTest) setFailOnAnte(fail bool) {
@@ -559,6 +679,7 @@ func (tx txTest) ValidateBasic() error { return nil }
 const (
 	routeMsgCounter  = "msgCounter"
 	routeMsgCounter2 = "msgCounter2"
+	routeMsgKeyValue = "msgKeyValue"
 )
 
 // ValidateBasic() fails on negative counters.
@@ -591,7 +712,7 @@ func newTxCounter(counter int64, msgCounters ...int64) *txTest {
 		msgs = append(msgs, msgCounter{c, false})
 	}
 
-	return &txTest{msgs, counter, false}
+	return &txTest{msgs, counter, false, false}
 }
 
 // a msg we dont know how to route
@@ -631,6 +752,26 @@ func (msg msgCounter2) ValidateBasic() error {
 }
 
 // amino decode
+// msgKeyValue sets a key/value pair in the store.
+type msgKeyValue struct {
+	Key   []byte
+	Value []byte
+}
+
+func (msg msgKeyValue) Reset()                       {}
+func (msg msgKeyValue) String() string               { return "TODO" }
+func (msg msgKeyValue) ProtoMessage()                {}
+func (msg msgKeyValue) Route() string                { return routeMsgKeyValue }
+func (msg msgKeyValue) Type() string                 { return "keyValue" }
+func (msg msgKeyValue) GetSignBytes() []byte         { return nil }
+func (msg msgKeyValue) GetSigners() []sdk.AccAddress { return nil }
+func (msg msgKeyValue) ValidateBasic() error {
+	if msg.Key == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "key cannot be nil")
+	}
+	return nil
+}
+
 func testTxDecoder(cdc *codec.Codec) sdk.TxDecoder {
 	return func(txBytes []byte) (sdk.Tx, error) {
 		var tx txTest
@@ -1057,7 +1198,7 @@ func TestRunInvalidTransaction(t *testing.T) {
 
 	// transaction with no known route
 	{
-		unknownRouteTx := txTest{[]sdk.Msg{msgNoRoute{}}, 0, false}
+		unknownRouteTx := txTest{[]sdk.Msg{msgNoRoute{}}, 0, false, false}
 		_, result, err := app.Deliver(unknownRouteTx)
 		require.Error(t, err)
 		require.Nil(t, result)
@@ -1066,7 +1207,7 @@ func TestRunInvalidTransaction(t *testing.T) {
 		require.EqualValues(t, sdkerrors.ErrUnknownRequest.Codespace(), space, err)
 		require.EqualValues(t, sdkerrors.ErrUnknownRequest.ABCICode(), code, err)
 
-		unknownRouteTx = txTest{[]sdk.Msg{msgCounter{}, msgNoRoute{}}, 0, false}
+		unknownRouteTx = txTest{[]sdk.Msg{msgCounter{}, msgNoRoute{}}, 0, false, false}
 		_, result, err = app.Deliver(unknownRouteTx)
 		require.Error(t, err)
 		require.Nil(t, result)
@@ -1678,3 +1819,696 @@ func TestWithRouter(t *testing.T) {
 		app.Commit()
 	}
 }
+
+// setupBaseAppWithSnapshots creates a baseapp with a snapshot store, taking a snapshot every
+// two blocks, and commits the given number of blocks with txs writing to the store.
+func setupBaseAppWithSnapshots(t *testing.T, blocks uint, blockTxs int) (*BaseApp, func()) {
+	codec := codec.New()
+	registerTestCodec(codec)
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			kv := msg.(*msgKeyValue)
+			ctx.KVStore(capKey2).Set(kv.Key, kv.Value)
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	snapshotDir, err := ioutil.TempDir("", "baseapp")
+	require.NoError(t, err)
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), snapshotDir)
+	require.NoError(t, err)
+	teardown := func() {
+		os.RemoveAll(snapshotDir)
+	}
+
+	app := setupBaseApp(t, routerOpt, SetSnapshotStore(snapshotStore), SetSnapshotInterval(2), SetPruning(store.PruneNothing))
+
+	app.InitChain(abci.RequestInitChain{})
+
+	r := rand.New(rand.NewSource(3920758213583))
+	keyCounter := 0
+	for height := int64(1); height <= int64(blocks); height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		for txNum := 0; txNum < blockTxs; txNum++ {
+			tx := txTest{Msgs: []sdk.Msg{}}
+			for msgNum := 0; msgNum < 100; msgNum++ {
+				key := []byte(fmt.Sprintf("%v", keyCounter))
+				value := make([]byte, 10000)
+				_, err := r.Read(value)
+				require.NoError(t, err)
+				tx.Msgs = append(tx.Msgs, &msgKeyValue{Key: key, Value: value})
+				keyCounter++
+			}
+			txBytes, err := codec.MarshalBinaryBare(tx)
+			require.NoError(t, err)
+			resp := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+			require.True(t, resp.IsOK(), "%v", resp.String())
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+
+		// Wait for snapshot to be taken, since it happens asynchronously.
+		if uint64(height)%app.snapshotInterval == 0 {
+			app.snapshotManager.WaitCreated(uint64(height))
+
+			snapshot, err := snapshotStore.Get(uint64(height), snapshottypes.CurrentFormat)
+			require.NoError(t, err)
+			require.NotNil(t, snapshot)
+		}
+	}
+
+	return app, teardown
+}
+
+func TestListSnapshots(t *testing.T) {
+	app, teardown := setupBaseAppWithSnapshots(t, 5, 4)
+	defer teardown()
+
+	resp := app.ListSnapshots(snapshottypes.RequestListSnapshots{})
+	for _, s := range resp.Snapshots {
+		assert.NotEmpty(t, s.Hash)
+		assert.NotEmpty(t, s.Metadata)
+		s.Hash = nil
+		s.Metadata = snapshottypes.Metadata{}
+	}
+	assert.Equal(t, snapshottypes.ResponseListSnapshots{Snapshots: []*snapshottypes.Snapshot{
+		{Height: 4, Format: 1, Chunks: 2},
+		{Height: 2, Format: 1, Chunks: 1},
+	}}, resp)
+}
+
+func TestLoadSnapshotChunk(t *testing.T) {
+	app, teardown := setupBaseAppWithSnapshots(t, 2, 5)
+	defer teardown()
+
+	testcases := map[string]struct {
+		height      uint64
+		format      uint32
+		chunk       uint32
+		expectEmpty bool
+	}{
+		"Existing snapshot": {2, 1, 1, false},
+		"Missing height":    {100, 1, 1, true},
+		"Missing format":    {2, 2, 1, true},
+		"Missing chunk":     {2, 1, 9, true},
+		"Zero height":       {0, 1, 1, true},
+		"Zero format":       {2, 0, 1, true},
+		"Zero chunk":        {2, 1, 0, false},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			resp := app.LoadSnapshotChunk(snapshottypes.RequestLoadSnapshotChunk{
+				Height: tc.height,
+				Format: tc.format,
+				Chunk:  tc.chunk,
+			})
+			if tc.expectEmpty {
+				assert.Equal(t, snapshottypes.ResponseLoadSnapshotChunk{}, resp)
+				return
+			}
+			assert.NotEmpty(t, resp.Chunk)
+		})
+	}
+}
+
+func TestOfferSnapshot_Errors(t *testing.T) {
+	// Set up app before test cases, since it's fairly expensive.
+	app, teardown := setupBaseAppWithSnapshots(t, 0, 0)
+	defer teardown()
+
+	m := snapshottypes.Metadata{ChunkHashes: [][]byte{{1}, {2}, {3}}}
+	hash := []byte{1, 2, 3}
+
+	testcases := map[string]struct {
+		snapshot *snapshottypes.Snapshot
+		result   snapshottypes.OfferSnapshotResult
+	}{
+		"nil snapshot": {nil, snapshottypes.OfferSnapshotReject},
+		"invalid format": {&snapshottypes.Snapshot{
+			Height: 1, Format: 9, Chunks: 3, Hash: hash, Metadata: m,
+		}, snapshottypes.OfferSnapshotRejectFormat},
+		"incorrect chunk count": {&snapshottypes.Snapshot{
+			Height: 1, Format: 1, Chunks: 2, Hash: hash, Metadata: m,
+		}, snapshottypes.OfferSnapshotReject},
+		"no chunks": {&snapshottypes.Snapshot{
+			Height: 1, Format: 1, Chunks: 0, Hash: hash, Metadata: m,
+		}, snapshottypes.OfferSnapshotReject},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			resp := app.OfferSnapshot(snapshottypes.RequestOfferSnapshot{Snapshot: tc.snapshot})
+			assert.Equal(t, tc.result, resp.Result)
+		})
+	}
+
+	// Offering a snapshot after one has been accepted should error
+	resp := app.OfferSnapshot(snapshottypes.RequestOfferSnapshot{Snapshot: &snapshottypes.Snapshot{
+		Height:   1,
+		Format:   snapshottypes.CurrentFormat,
+		Chunks:   3,
+		Hash:     []byte{1, 2, 3},
+		Metadata: snapshottypes.Metadata{ChunkHashes: [][]byte{{1}, {2}, {3}}},
+	}})
+	require.Equal(t, snapshottypes.ResponseOfferSnapshot{Result: snapshottypes.OfferSnapshotAccept}, resp)
+
+	resp = app.OfferSnapshot(snapshottypes.RequestOfferSnapshot{Snapshot: &snapshottypes.Snapshot{
+		Height:   2,
+		Format:   snapshottypes.CurrentFormat,
+		Chunks:   3,
+		Hash:     []byte{1, 2, 3},
+		Metadata: snapshottypes.Metadata{ChunkHashes: [][]byte{{1}, {2}, {3}}},
+	}})
+	require.Equal(t, snapshottypes.ResponseOfferSnapshot{Result: snapshottypes.OfferSnapshotAbort}, resp)
+}
+
+func TestApplySnapshotChunk(t *testing.T) {
+	source, teardown := setupBaseAppWithSnapshots(t, 4, 10)
+	defer teardown()
+
+	target, teardown := setupBaseAppWithSnapshots(t, 0, 0)
+	defer teardown()
+
+	// Fetch latest snapshot to restore
+	respList := source.ListSnapshots(snapshottypes.RequestListSnapshots{})
+	require.NotEmpty(t, respList.Snapshots)
+	snapshot := respList.Snapshots[0]
+
+	// Make sure the snapshot has at least 3 chunks
+	require.GreaterOrEqual(t, snapshot.Chunks, uint32(3), "Not enough snapshot chunks")
+
+	// Begin a snapshot restoration in the target
+	respOffer := target.OfferSnapshot(snapshottypes.RequestOfferSnapshot{Snapshot: snapshot})
+	require.Equal(t, snapshottypes.ResponseOfferSnapshot{Result: snapshottypes.OfferSnapshotAccept}, respOffer)
+
+	// We should be able to pass an invalid chunk and get a verify failure, before reapplying it.
+	respApply := target.ApplySnapshotChunk(snapshottypes.RequestApplySnapshotChunk{
+		Index:  0,
+		Chunk:  []byte{9},
+		Sender: "sender",
+	})
+	require.Equal(t, snapshottypes.ResponseApplySnapshotChunk{
+		Result:        snapshottypes.ApplySnapshotChunkRetry,
+		RefetchChunks: []uint32{0},
+		RejectSenders: []string{"sender"},
+	}, respApply)
+
+	// Fetch each chunk from the source and apply it to the target
+	for index := uint32(0); index < snapshot.Chunks; index++ {
+		respChunk := source.LoadSnapshotChunk(snapshottypes.RequestLoadSnapshotChunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  index,
+		})
+		require.NotNil(t, respChunk.Chunk)
+		respApply := target.ApplySnapshotChunk(snapshottypes.RequestApplySnapshotChunk{
+			Index: index,
+			Chunk: respChunk.Chunk,
+		})
+		require.Equal(t, snapshottypes.ResponseApplySnapshotChunk{
+			Result: snapshottypes.ApplySnapshotChunkAccept,
+		}, respApply)
+	}
+
+	// The target should now have the same hash as the source
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+}
+
+func TestCreateQueryContext(t *testing.T) {
+	pruningOpt := SetPruning(store.PruningOptions{KeepRecent: 2, KeepEvery: 3, Interval: 1})
+	app := setupBaseApp(t, pruningOpt)
+
+	for i := int64(1); i <= 7; i++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: i}})
+		app.Commit()
+	}
+
+	testCases := []struct {
+		name      string
+		height    int64
+		prove     bool
+		expHeight int64
+		expErr    string
+	}{
+		{"latest height", 0, false, 7, ""},
+		{"explicit latest height", 7, false, 7, ""},
+		{"historical height", 3, true, 3, ""},
+		{"pruned height", 4, false, 0, "state at height 4 is not available, it may have been pruned (latest height: 7)"},
+		{"future height", 8, false, 0, "cannot query with height in the future; height: 8, latest height: 7"},
+		{"negative height", -1, false, 0, "cannot query with negative height -1"},
+		{"proof at height 1", 1, true, 0, "cannot query with proof when height <= 1"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, release, err := app.createQueryContext(tc.height, tc.prove)
+			if tc.expErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			defer release()
+			require.Equal(t, tc.expHeight, ctx.BlockHeight())
+		})
+	}
+}
+
+func TestCreateQueryContextWithSnapshots(t *testing.T) {
+	pruningOpt := SetPruning(store.PruningOptions{KeepRecent: 2, KeepEvery: 3, Interval: 1})
+	app := setupBaseApp(t, pruningOpt, SetQuerySnapshots(3))
+
+	for i := int64(1); i <= 7; i++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: i, ChainID: "test-chain"}})
+		app.deliverState.ctx.KVStore(capKey1).Set([]byte("height"), []byte{byte(i)})
+		app.Commit()
+	}
+
+	// the pool only holds the snapshots of the versions that were not pruned
+	var versions []int64
+	for _, snapshot := range app.queryPool.load() {
+		versions = append(versions, snapshot.store.Version())
+	}
+	require.Equal(t, []int64{5, 6, 7}, versions)
+
+	// the header of a historical block is only known for the snapshots
+	testCases := []struct {
+		name       string
+		height     int64
+		expHeight  int64
+		expChainID string
+		expErr     string
+	}{
+		{"latest height", 0, 7, "test-chain", ""},
+		{"snapshot height", 6, 6, "test-chain", ""},
+		{"historical height", 3, 3, "", ""},
+		{"pruned height", 4, 0, "", "state at height 4 is not available, it may have been pruned (latest height: 7)"},
+		{"future height", 8, 0, "", "cannot query with height in the future; height: 8, latest height: 7"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, release, err := app.createQueryContext(tc.height, false)
+			if tc.expErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			defer release()
+			require.Equal(t, tc.expHeight, ctx.BlockHeight())
+			require.Equal(t, tc.expChainID, ctx.ChainID())
+			require.Equal(t, []byte{byte(tc.expHeight)}, ctx.KVStore(capKey1).Get([]byte("height")))
+
+			// writes to the query context are discarded
+			ctx.KVStore(capKey1).Set([]byte("height"), []byte{0})
+		})
+	}
+}
+
+func TestQuerySnapshotsConcurrentCommit(t *testing.T) {
+	app := setupBaseApp(t, SetQuerySnapshots(2))
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	app.Commit()
+
+	done := make(chan struct{})
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(errCh)
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			ctx, release, err := app.createQueryContext(0, false)
+			if err != nil {
+				errCh <- err
+				return
+			}
+
+			value := ctx.KVStore(capKey1).Get([]byte("height"))
+			release()
+			if ctx.BlockHeight() > 1 && (len(value) != 1 || int64(value[0]) != ctx.BlockHeight()) {
+				errCh <- fmt.Errorf("unexpected value %X at height %d", value, ctx.BlockHeight())
+				return
+			}
+		}
+	}()
+
+	for i := int64(2); i <= 50; i++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: i}})
+		app.deliverState.ctx.KVStore(capKey1).Set([]byte("height"), []byte{byte(i)})
+		app.Commit()
+	}
+
+	close(done)
+	require.NoError(t, <-errCh)
+}
+
+func TestQuerySnapshotsConcurrentPruning(t *testing.T) {
+	const keys = 100
+
+	queryOpt := func(bapp *BaseApp) {
+		bapp.QueryRouter().AddRoute("height", func(ctx sdk.Context, _ []string, _ abci.RequestQuery) ([]byte, error) {
+			// read every key, so that the query is still reading when its version
+			// is pruned
+			iter := ctx.KVStore(capKey1).Iterator(nil, nil)
+			defer iter.Close()
+
+			n := 0
+			for ; iter.Valid(); iter.Next() {
+				if !bytes.Equal(iter.Value(), []byte{byte(ctx.BlockHeight())}) {
+					return nil, fmt.Errorf("unexpected value %X at height %d", iter.Value(), ctx.BlockHeight())
+				}
+				n++
+			}
+
+			if n != keys {
+				return nil, fmt.Errorf("unexpected number of keys %d at height %d", n, ctx.BlockHeight())
+			}
+
+			return []byte{byte(ctx.BlockHeight())}, nil
+		})
+	}
+
+	pruningOpt := SetPruning(store.PruningOptions{KeepRecent: 1, KeepEvery: 0, Interval: 1})
+	app := setupBaseApp(t, queryOpt, pruningOpt, SetQuerySnapshots(3))
+
+	commit := func(height int64) {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		for k := 0; k < keys; k++ {
+			app.deliverState.ctx.KVStore(capKey1).Set([]byte(fmt.Sprintf("key%03d", k)), []byte{byte(height)})
+		}
+		app.Commit()
+	}
+
+	commit(1)
+	commit(2)
+
+	done := make(chan struct{})
+	errCh := make(chan error, 4)
+
+	var wg sync.WaitGroup
+	for i := 0; i < cap(errCh); i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				// query the version prior to the latest, which is the next one
+				// to be pruned
+				latest := app.Query(abci.RequestQuery{Path: "custom/height"})
+				if !latest.IsOK() {
+					errCh <- fmt.Errorf("failed to query the latest height: %s", latest.Log)
+					return
+				}
+
+				res := app.Query(abci.RequestQuery{Path: "custom/height", Height: latest.Height - 1})
+				switch {
+				case res.IsOK():
+					if !bytes.Equal(res.Value, []byte{byte(res.Height)}) {
+						errCh <- fmt.Errorf("unexpected response %X at height %d", res.Value, res.Height)
+						return
+					}
+
+				case res.Codespace != sdkerrors.ErrInvalidHeight.Codespace() || res.Code != sdkerrors.ErrInvalidHeight.ABCICode():
+					errCh <- fmt.Errorf("unexpected error at height %d: %s", latest.Height-1, res.Log)
+					return
+				}
+			}
+		}()
+	}
+
+	for i := int64(3); i <= 50; i++ {
+		commit(i)
+	}
+
+	close(done)
+	wg.Wait()
+	close(errCh)
+
+	for err := range errCh {
+		require.NoError(t, err)
+	}
+}
+
+func TestPostHandler(t *testing.T) {
+	gasWanted := uint64(100)
+	anteKey := []byte("ante-key")
+	msgKey := []byte("msg-key")
+	refundKey := []byte("refund-key")
+
+	// the stores are accessed without gas to make the gas used predictable
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			newCtx := ctx.WithGasMeter(sdk.NewGasMeter(gasWanted))
+			newCtx.MultiStore().GetKVStore(capKey1).Set(anteKey, []byte{1})
+
+			return newCtx, nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			msgCounter := msg.(msgCounter)
+			ctx.GasMeter().ConsumeGas(uint64(msgCounter.Counter), "counter-handler")
+			ctx.MultiStore().GetKVStore(capKey1).Set(msgKey, []byte{1})
+
+			if msgCounter.FailOnHandler {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+			}
+
+			return &sdk.Result{}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	type postHandlerCall struct {
+		success            bool
+		gasWanted, gasUsed uint64
+	}
+
+	var calls []postHandlerCall
+
+	postOpt := func(bapp *BaseApp) {
+		bapp.SetPostHandler(func(ctx sdk.Context, tx sdk.Tx, simulate, success bool, gasWanted, gasUsed uint64) (sdk.Context, error) {
+			calls = append(calls, postHandlerCall{success, gasWanted, gasUsed})
+
+			if tx.(*txTest).FailOnPost {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "post handler failure")
+			}
+
+			// refund the unused gas
+			setIntOnStore(ctx.MultiStore().GetKVStore(capKey1), refundKey, int64(gasWanted-gasUsed))
+			ctx.EventManager().EmitEvent(sdk.NewEvent("refund"))
+
+			return ctx, nil
+		})
+	}
+
+	testCases := []struct {
+		name      string
+		tx        *txTest
+		expCall   postHandlerCall
+		expErr    bool
+		expMsgs   bool
+		expRefund int64
+	}{
+		{
+			"messages succeed",
+			&txTest{Msgs: []sdk.Msg{msgCounter{Counter: 30}}},
+			postHandlerCall{true, 100, 30},
+			false, true, 70,
+		},
+		{
+			"messages fail",
+			&txTest{Msgs: []sdk.Msg{msgCounter{Counter: 40, FailOnHandler: true}}},
+			postHandlerCall{false, 100, 40},
+			true, false, 60,
+		},
+		{
+			"post handler fails",
+			&txTest{Msgs: []sdk.Msg{msgCounter{Counter: 10}}, FailOnPost: true},
+			postHandlerCall{true, 100, 10},
+			true, false, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			app := setupBaseApp(t, anteOpt, routerOpt, postOpt)
+			app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+			calls = nil
+
+			// the PostHandler is not run in CheckTx as messages are not executed
+			_, _, err := app.Check(tc.tx)
+			require.NoError(t, err)
+			require.Empty(t, calls)
+
+			_, result, err := app.Deliver(tc.tx)
+			require.Equal(t, []postHandlerCall{tc.expCall}, calls)
+
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "refund", result.Events[len(result.Events)-1].Type)
+			}
+
+			store := app.deliverState.ctx.KVStore(capKey1)
+			require.True(t, store.Has(anteKey))
+			require.Equal(t, tc.expMsgs, store.Has(msgKey))
+			require.Equal(t, tc.expRefund, getIntFromStore(store, refundKey))
+		})
+	}
+}
+
+func TestBlockGasReport(t *testing.T) {
+	key1, key2 := []byte("key1"), []byte("key2")
+	value := []byte("value")
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		r1 := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx.GasMeter().ConsumeGas(uint64(msg.(msgCounter).Counter), "counter-handler")
+			ctx.KVStore(capKey1).Set(key1, value)
+
+			return &sdk.Result{}, nil
+		})
+		r2 := sdk.NewRoute(routeMsgCounter2, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx.GasMeter().ConsumeGas(uint64(msg.(msgCounter2).Counter), "counter-handler")
+			ctx.KVStore(capKey2).Set(key2, value)
+
+			return &sdk.Result{}, nil
+		})
+		bapp.Router().AddRoute(r1).AddRoute(r2)
+	}
+
+	gasConfig := store.KVGasConfig()
+	writeGas := gasConfig.WriteCostFlat + gasConfig.WriteCostPerByte*uint64(len(value))
+
+	deliverBlock := func(app *BaseApp, height int64) abci.ResponseEndBlock {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+
+		txs := []*txTest{
+			{Msgs: []sdk.Msg{msgCounter{Counter: 10}, msgCounter2{Counter: 5}}},
+			{Msgs: []sdk.Msg{msgCounter{Counter: 20}}},
+		}
+		for _, tx := range txs {
+			_, _, err := app.Deliver(tx)
+			require.NoError(t, err)
+		}
+
+		res := app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+
+		return res
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		app := setupBaseApp(t, anteOpt, routerOpt)
+		res := deliverBlock(app, 1)
+		require.Empty(t, res.Events)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		app := setupBaseApp(t, anteOpt, routerOpt, SetBlockGasReport(true))
+
+		gasUsed := func(gas uint64) sdk.Attribute {
+			return sdk.NewAttribute(AttributeKeyGasUsed, fmt.Sprint(gas))
+		}
+
+		expected := sdk.Events{
+			sdk.NewEvent(EventTypeBlockGas, gasUsed(10+20+5+3*writeGas)),
+			sdk.NewEvent(
+				EventTypeBlockGasMsg,
+				sdk.NewAttribute(AttributeKeyMsgType, "msgCounter/counter1"),
+				sdk.NewAttribute(AttributeKeyMsgCount, "2"),
+				gasUsed(10+20+2*writeGas),
+			),
+			sdk.NewEvent(
+				EventTypeBlockGasMsg,
+				sdk.NewAttribute(AttributeKeyMsgType, "msgCounter2/counter2"),
+				sdk.NewAttribute(AttributeKeyMsgCount, "1"),
+				gasUsed(5+writeGas),
+			),
+			sdk.NewEvent(EventTypeBlockGasStore, sdk.NewAttribute(AttributeKeyStoreKey, capKey1.Name()), gasUsed(2*writeGas)),
+			sdk.NewEvent(EventTypeBlockGasStore, sdk.NewAttribute(AttributeKeyStoreKey, capKey2.Name()), gasUsed(writeGas)),
+		}.ToABCIEvents()
+
+		// the report is reset on every block
+		for height := int64(1); height <= 2; height++ {
+			res := deliverBlock(app, height)
+			require.Equal(t, expected, res.Events)
+		}
+	})
+}
+
+func TestHaltTime(t *testing.T) {
+	haltTime := time.Unix(1000, 0)
+	app := setupBaseApp(t, SetHaltTime(uint64(haltTime.Unix())))
+	app.InitChain(abci.RequestInitChain{})
+
+	header := abci.Header{Height: 1, Time: haltTime.Add(-time.Second)}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.Commit()
+
+	select {
+	case <-app.Halted():
+		t.Fatal("app halted before the halt time")
+	default:
+	}
+
+	header = abci.Header{Height: 2, Time: haltTime}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.Commit()
+
+	select {
+	case <-app.Halted():
+	default:
+		t.Fatal("app did not halt at the halt time")
+	}
+
+	require.Equal(t, int64(2), app.LastBlockHeight())
+
+	// no further blocks are processed, nor do they panic, until the node stops
+	begun := make(chan struct{})
+	go func() {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 3, Time: haltTime}})
+		close(begun)
+	}()
+
+	select {
+	case <-begun:
+		t.Fatal("app began a block after halting")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.Equal(t, int64(2), app.LastBlockHeight())
+}
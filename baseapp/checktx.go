@@ -0,0 +1,233 @@
+package baseapp
+
+import (
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// reexecutedLane is the lane recorded as the writer of keys written by
+// transactions which were re-executed serially.
+const reexecutedLane = -1
+
+// checkTxResult is the outcome of running a transaction in CheckTx mode
+// together with the state it accessed.
+type checkTxResult struct {
+	gInfo  sdk.GasInfo
+	result *sdk.Result
+	err    error
+	access *accessLog
+}
+
+// CheckTxBatch runs CheckTx for a batch of transactions and returns their
+// responses in the order of the requests. The resulting check state and
+// responses are the same as if CheckTx was called for each request in order.
+//
+// If the BaseApp has more than one CheckTx worker, transactions are grouped into
+// lanes of transactions sharing signers, and the lanes run concurrently on
+// separate branches of the check state. The results are then committed in
+// request order, recording the keys each transaction read and wrote: a
+// transaction which read state written by a transaction of another lane, or
+// which follows such a transaction in its lane, is discarded and run again
+// serially. Transactions touching shared state, such as fees paid into the same
+// module account, therefore fall back to serial execution.
+func (app *BaseApp) CheckTxBatch(reqs []abci.RequestCheckTx) []abci.ResponseCheckTx {
+	responses := make([]abci.ResponseCheckTx, len(reqs))
+
+	if app.checkTxWorkers <= 1 {
+		for i, req := range reqs {
+			responses[i] = app.CheckTx(req)
+		}
+
+		return responses
+	}
+
+	defer telemetry.MeasureSince("abci", "check_tx_batch")
+
+	txs := make([]sdk.Tx, len(reqs))
+	modes := make([]runTxMode, len(reqs))
+
+	for i, req := range reqs {
+		tx, err := app.txDecoder(req.Tx)
+		if err != nil {
+			responses[i] = sdkerrors.ResponseCheckTx(err, 0, 0, app.trace)
+			continue
+		}
+
+		txs[i] = tx
+		modes[i] = checkTxMode(req)
+	}
+
+	lanes, laneOf := checkTxLanes(txs)
+	results := make([]checkTxResult, len(reqs))
+
+	// run the lanes concurrently, each on its own branch of the check state
+	chLanes := make(chan []int)
+	wg := sync.WaitGroup{}
+
+	workers := app.checkTxWorkers
+	if workers > len(lanes) {
+		workers = len(lanes)
+	}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for lane := range chLanes {
+				ms := newAccessMultiStore(app.checkState.ms.CacheMultiStore())
+				for _, i := range lane {
+					results[i] = app.runCheckTx(ms, modes[i], reqs[i].Tx, txs[i])
+				}
+			}
+		}()
+	}
+
+	for _, lane := range lanes {
+		chLanes <- lane
+	}
+
+	close(chLanes)
+	wg.Wait()
+
+	// commit the results in request order, re-executing those that conflict
+	lastWriters := make(map[sdk.StoreKey]map[string]int)
+	tainted := make(map[int]bool)
+
+	for i, tx := range txs {
+		if tx == nil {
+			continue
+		}
+
+		lane := laneOf[i]
+		res := results[i]
+
+		if tainted[lane] || res.access.conflicts(lastWriters, lane) {
+			tainted[lane] = true
+			lane = reexecutedLane
+			res = app.runCheckTx(newAccessMultiStore(app.checkState.ms), modes[i], reqs[i].Tx, tx)
+		} else {
+			res.access.apply(app.checkState.ms)
+		}
+
+		for _, w := range res.access.writes {
+			writers, ok := lastWriters[w.storeKey]
+			if !ok {
+				writers = make(map[string]int)
+				lastWriters[w.storeKey] = writers
+			}
+
+			writers[string(w.key)] = lane
+		}
+
+		responses[i] = app.checkTxResponse(res.gInfo, res.result, res.err)
+	}
+
+	return responses
+}
+
+// runCheckTx runs a transaction on the given access recording MultiStore,
+// starting a new access log for it.
+func (app *BaseApp) runCheckTx(ms *accessMultiStore, mode runTxMode, txBytes []byte, tx sdk.Tx) checkTxResult {
+	ms.log = newAccessLog()
+
+	// The gas meter and event manager of the check state are shared by all
+	// transactions, which may run concurrently, so each transaction gets its own.
+	// The AnteHandler is expected to replace the gas meter anyway.
+	ctx := app.getContextForTx(mode, txBytes).
+		WithMultiStore(ms).
+		WithGasMeter(sdk.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager())
+
+	gInfo, result, err := app.runTxWithContext(ctx, mode, txBytes, tx)
+
+	return checkTxResult{gInfo: gInfo, result: result, err: err, access: ms.log}
+}
+
+// checkTxLanes groups the given transactions into lanes, such that transactions
+// sharing a signer or fee payer are in the same lane. Lanes list the indexes of
+// their transactions in order, and are ordered by their first transaction. Nil
+// transactions are not assigned a lane.
+func checkTxLanes(txs []sdk.Tx) (lanes [][]int, laneOf []int) {
+	parents := make([]int, len(txs))
+	owners := make(map[string]int)
+
+	var find func(i int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+
+		return parents[i]
+	}
+
+	for i, tx := range txs {
+		parents[i] = i
+		if tx == nil {
+			continue
+		}
+
+		for _, addr := range txAccounts(tx) {
+			owner, ok := owners[string(addr)]
+			if !ok {
+				owners[string(addr)] = i
+				continue
+			}
+
+			// the root of a union is always its lowest index
+			root, other := find(owner), find(i)
+			if root > other {
+				root, other = other, root
+			}
+
+			parents[other] = root
+		}
+	}
+
+	laneOf = make([]int, len(txs))
+	laneIndexes := make(map[int]int)
+
+	for i, tx := range txs {
+		if tx == nil {
+			continue
+		}
+
+		root := find(i)
+
+		lane, ok := laneIndexes[root]
+		if !ok {
+			lane = len(lanes)
+			laneIndexes[root] = lane
+			lanes = append(lanes, nil)
+		}
+
+		laneOf[i] = lane
+		lanes[lane] = append(lanes[lane], i)
+	}
+
+	return lanes, laneOf
+}
+
+// txAccounts returns the signers of a transaction's messages and, if it has
+// one, its fee payer.
+func txAccounts(tx sdk.Tx) []sdk.AccAddress {
+	var accounts []sdk.AccAddress
+
+	for _, msg := range tx.GetMsgs() {
+		accounts = append(accounts, msg.GetSigners()...)
+	}
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		if payer := feeTx.FeePayer(); !payer.Empty() {
+			accounts = append(accounts, payer)
+		}
+	}
+
+	return accounts
+}
@@ -0,0 +1,309 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const routeMsgSigner = "msgSigner"
+
+var sharedKey = []byte("shared")
+
+// msgSigner is signed by an account, and increments its sequence in the ante
+// handler. It can also touch state shared with other accounts.
+type msgSigner struct {
+	Signer   sdk.AccAddress
+	Sequence int64
+	Fee      bool           // increment the shared key
+	Peek     sdk.AccAddress // read the sequence of another account
+	Count    bool           // store the number of accounts with a sequence
+}
+
+func (msg msgSigner) Reset()                       {}
+func (msg msgSigner) String() string               { return "TODO" }
+func (msg msgSigner) ProtoMessage()                {}
+func (msg msgSigner) Route() string                { return routeMsgSigner }
+func (msg msgSigner) Type() string                 { return "signer" }
+func (msg msgSigner) GetSignBytes() []byte         { return nil }
+func (msg msgSigner) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+func (msg msgSigner) ValidateBasic() error         { return nil }
+
+func sequenceKey(addr sdk.AccAddress) []byte {
+	return append([]byte("seq/"), addr...)
+}
+
+func anteHandlerSigners(capKey sdk.StoreKey) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		store := ctx.KVStore(capKey)
+
+		for _, m := range tx.GetMsgs() {
+			msg := m.(*msgSigner)
+
+			if msg.Peek != nil {
+				store.Get(sequenceKey(msg.Peek))
+			}
+
+			if msg.Count {
+				var count int64
+
+				iter := sdk.KVStorePrefixIterator(store, []byte("seq/"))
+				for ; iter.Valid(); iter.Next() {
+					count++
+				}
+				iter.Close()
+
+				setIntOnStore(store, append([]byte("count/"), msg.Signer...), count)
+			}
+
+			seq := getIntFromStore(store, sequenceKey(msg.Signer))
+			if seq != msg.Sequence {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidSequence, "expected %d, got %d", seq, msg.Sequence)
+			}
+
+			setIntOnStore(store, sequenceKey(msg.Signer), seq+1)
+
+			if msg.Fee {
+				setIntOnStore(store, sharedKey, getIntFromStore(store, sharedKey)+1)
+			}
+		}
+
+		if tx.(txTest).FailOnAnte {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+		}
+
+		return ctx, nil
+	}
+}
+
+func newTxSigner(msgs ...*msgSigner) *txTest {
+	tx := &txTest{}
+	for _, msg := range msgs {
+		tx.Msgs = append(tx.Msgs, msg)
+	}
+
+	return tx
+}
+
+func checkStateKVPairs(app *BaseApp, key sdk.StoreKey) []string {
+	var pairs []string
+
+	iter := app.checkState.ms.GetKVStore(key).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		pairs = append(pairs, fmt.Sprintf("%X=%X", iter.Key(), iter.Value()))
+	}
+
+	return pairs
+}
+
+func TestCheckTxBatch(t *testing.T) {
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	a, b, c, d, e, f := sdk.AccAddress("a"), sdk.AccAddress("b"), sdk.AccAddress("c"),
+		sdk.AccAddress("d"), sdk.AccAddress("e"), sdk.AccAddress("f")
+
+	failing := newTxSigner(&msgSigner{Signer: a, Sequence: 2})
+	failing.setFailOnAnte(true)
+
+	txs := []*txTest{
+		newTxSigner(&msgSigner{Signer: a, Sequence: 0}),
+		newTxSigner(&msgSigner{Signer: b, Sequence: 0}),
+		newTxSigner(&msgSigner{Signer: a, Sequence: 1}),
+		newTxSigner(&msgSigner{Signer: c, Sequence: 0, Fee: true}),
+		newTxSigner(&msgSigner{Signer: d, Sequence: 0, Fee: true}),
+		newTxSigner(&msgSigner{Signer: e, Sequence: 0, Peek: a}),
+		newTxSigner(&msgSigner{Signer: b, Sequence: 0}),
+		newTxSigner(&msgSigner{Signer: c, Sequence: 1}),
+		newTxSigner(&msgSigner{Signer: d, Sequence: 1}),
+		newTxSigner(&msgSigner{Signer: f, Sequence: 0, Count: true}),
+		nil,
+		failing,
+		newTxSigner(&msgSigner{Signer: a, Sequence: 2}),
+		newTxSigner(&msgSigner{Signer: e, Sequence: 1}, &msgSigner{Signer: b, Sequence: 1, Fee: true}),
+	}
+
+	reqs := make([]abci.RequestCheckTx, len(txs))
+	for i, tx := range txs {
+		if tx == nil {
+			reqs[i] = abci.RequestCheckTx{Tx: []byte("invalid")}
+			continue
+		}
+
+		txBytes, err := codec.MarshalBinaryBare(tx)
+		require.NoError(t, err)
+		reqs[i] = abci.RequestCheckTx{Tx: txBytes}
+	}
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerSigners(capKey1)) }
+
+	serial := setupBaseApp(t, anteOpt)
+	serial.InitChain(abci.RequestInitChain{})
+
+	expected := make([]abci.ResponseCheckTx, len(reqs))
+	for i, req := range reqs {
+		expected[i] = serial.CheckTx(req)
+	}
+
+	for _, workers := range []int{0, 1, 2, 4, 16} {
+		workers := workers
+		t.Run(fmt.Sprintf("workers=%d", workers), func(t *testing.T) {
+			app := setupBaseApp(t, anteOpt, SetCheckTxWorkers(workers))
+			app.InitChain(abci.RequestInitChain{})
+
+			responses := app.CheckTxBatch(reqs)
+			require.Equal(t, expected, responses)
+			require.Equal(t, checkStateKVPairs(serial, capKey1), checkStateKVPairs(app, capKey1))
+		})
+	}
+
+	// sanity check the outcomes of the serial run
+	for i, res := range expected {
+		switch i {
+		case 6, 10, 11:
+			require.False(t, res.IsOK(), "tx %d", i)
+		default:
+			require.True(t, res.IsOK(), "tx %d: %v", i, res.Log)
+		}
+	}
+}
+
+// Test that concurrent lanes emitting events do not share an event manager;
+// run with -race.
+func TestCheckTxBatchEvents(t *testing.T) {
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	signers := anteHandlerSigners(capKey1)
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			for _, msg := range tx.GetMsgs() {
+				ctx.EventManager().EmitEvent(sdk.NewEvent("signer", sdk.NewAttribute("address", msg.GetSigners()[0].String())))
+			}
+
+			newCtx, err := signers(ctx, tx, simulate)
+			if err != nil {
+				return newCtx, err
+			}
+
+			return newCtx.WithSender(tx.GetMsgs()[0].GetSigners()[0].String()), nil
+		})
+	}
+
+	var reqs []abci.RequestCheckTx
+
+	for i := 0; i < 64; i++ {
+		signer := sdk.AccAddress(fmt.Sprintf("signer%d", i%16))
+		txBytes, err := codec.MarshalBinaryBare(newTxSigner(&msgSigner{Signer: signer, Sequence: int64(i / 16)}))
+		require.NoError(t, err)
+
+		reqs = append(reqs, abci.RequestCheckTx{Tx: txBytes})
+	}
+
+	serial := setupBaseApp(t, anteOpt)
+	serial.InitChain(abci.RequestInitChain{})
+
+	expected := make([]abci.ResponseCheckTx, len(reqs))
+	for i, req := range reqs {
+		expected[i] = serial.CheckTx(req)
+		require.True(t, expected[i].IsOK(), expected[i].Log)
+	}
+
+	app := setupBaseApp(t, anteOpt, SetCheckTxWorkers(8))
+	app.InitChain(abci.RequestInitChain{})
+
+	require.Equal(t, expected, app.CheckTxBatch(reqs))
+	require.Empty(t, app.checkState.ctx.EventManager().Events())
+}
+
+func TestCheckTxLanes(t *testing.T) {
+	a, b, c, d := sdk.AccAddress("a"), sdk.AccAddress("b"), sdk.AccAddress("c"), sdk.AccAddress("d")
+
+	txs := []sdk.Tx{
+		*newTxSigner(&msgSigner{Signer: a}),
+		*newTxSigner(&msgSigner{Signer: b}),
+		nil,
+		*newTxSigner(&msgSigner{Signer: c}),
+		*newTxSigner(&msgSigner{Signer: c}, &msgSigner{Signer: a}),
+		*newTxSigner(&msgSigner{Signer: d}),
+		*newTxSigner(),
+		*newTxSigner(&msgSigner{Signer: b}),
+	}
+
+	lanes, laneOf := checkTxLanes(txs)
+	require.Equal(t, [][]int{{0, 3, 4}, {1, 7}, {5}, {6}}, lanes)
+	require.Equal(t, []int{0, 1, 0, 0, 0, 2, 3, 1}, laneOf)
+}
+
+func TestCheckTxMempoolEvent(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if tx.(txTest).Counter == 0 {
+				return ctx, nil
+			}
+
+			return ctx.WithPriority(tx.(txTest).Counter).WithSender("sender"), nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	// no event is added if the AnteHandler sets neither priority nor sender
+	txBytes, err := codec.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+	require.Empty(t, res.Events)
+
+	txBytes, err = codec.MarshalBinaryBare(newTxCounter(7, 0))
+	require.NoError(t, err)
+
+	for _, typ := range []abci.CheckTxType{abci.CheckTxType_New, abci.CheckTxType_Recheck} {
+		res = app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: typ})
+		require.True(t, res.IsOK(), res.Log)
+
+		expected := sdk.NewEvent(sdk.EventTypeMempool,
+			sdk.NewAttribute(sdk.AttributeKeyPriority, "7"),
+			sdk.NewAttribute(sdk.AttributeKeySender, "sender"),
+		)
+		require.Equal(t, []abci.Event{abci.Event(expected)}, res.Events)
+	}
+}
+
+func TestReCheckTxSkipsValidateBasic(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	// the message fails ValidateBasic
+	txBytes, err := codec.MarshalBinaryBare(newTxCounter(0, -1))
+	require.NoError(t, err)
+
+	res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+	require.False(t, res.IsOK())
+
+	res = app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck})
+	require.True(t, res.IsOK(), res.Log)
+}
@@ -0,0 +1,120 @@
+package baseapp
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Block gas report event types and attribute keys
+const (
+	EventTypeBlockGas      = "block_gas"
+	EventTypeBlockGasMsg   = "block_gas_msg"
+	EventTypeBlockGasStore = "block_gas_store"
+
+	AttributeKeyGasUsed  = "gas_used"
+	AttributeKeyMsgType  = "msg_type"
+	AttributeKeyMsgCount = "count"
+	AttributeKeyStoreKey = "store_key"
+)
+
+var _ sdk.StoreGasTracker = (*blockGasReport)(nil)
+
+// blockGasReport accumulates the gas consumed by the transactions of a block,
+// by message type and by the key of the store accessed.
+type blockGasReport struct {
+	msgGas   map[string]sdk.Gas
+	msgCount map[string]uint64
+	storeGas map[string]sdk.Gas
+}
+
+func newBlockGasReport() *blockGasReport {
+	return &blockGasReport{
+		msgGas:   make(map[string]sdk.Gas),
+		msgCount: make(map[string]uint64),
+		storeGas: make(map[string]sdk.Gas),
+	}
+}
+
+// msgType returns the type a message is reported under. Msg service messages
+// are reported under their method name, other messages under their route and
+// type.
+func msgType(msg sdk.Msg) string {
+	if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+		return svcMsg.MethodName
+	}
+
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+// trackMsgGas records the gas consumed by executing a message.
+func (r *blockGasReport) trackMsgGas(msg sdk.Msg, amount sdk.Gas) {
+	t := msgType(msg)
+	r.msgGas[t] += amount
+	r.msgCount[t]++
+}
+
+// TrackStoreGas implements the StoreGasTracker interface.
+func (r *blockGasReport) TrackStoreGas(key sdk.StoreKey, amount sdk.Gas) {
+	r.storeGas[key.Name()] += amount
+}
+
+// events returns the events summarizing the report, given the total gas
+// consumed by the block. Message types and store keys are sorted so that the
+// events are the same on every node.
+func (r *blockGasReport) events(total sdk.Gas) sdk.Events {
+	events := sdk.Events{
+		sdk.NewEvent(EventTypeBlockGas, sdk.NewAttribute(AttributeKeyGasUsed, fmt.Sprint(total))),
+	}
+
+	for _, t := range sortedKeys(r.msgGas) {
+		events = append(events, sdk.NewEvent(
+			EventTypeBlockGasMsg,
+			sdk.NewAttribute(AttributeKeyMsgType, t),
+			sdk.NewAttribute(AttributeKeyMsgCount, fmt.Sprint(r.msgCount[t])),
+			sdk.NewAttribute(AttributeKeyGasUsed, fmt.Sprint(r.msgGas[t])),
+		))
+	}
+
+	for _, key := range sortedKeys(r.storeGas) {
+		events = append(events, sdk.NewEvent(
+			EventTypeBlockGasStore,
+			sdk.NewAttribute(AttributeKeyStoreKey, key),
+			sdk.NewAttribute(AttributeKeyGasUsed, fmt.Sprint(r.storeGas[key])),
+		))
+	}
+
+	return events
+}
+
+// emitTelemetry emits the report as gauges, given the total gas consumed by the
+// block.
+func (r *blockGasReport) emitTelemetry(total sdk.Gas) {
+	telemetry.SetGauge(float32(total), "block", "gas", "used")
+
+	for _, t := range sortedKeys(r.msgGas) {
+		labels := []metrics.Label{telemetry.NewLabel(AttributeKeyMsgType, t)}
+		telemetry.SetGaugeWithLabels([]string{"block", "gas", "msg"}, float32(r.msgGas[t]), labels)
+		telemetry.SetGaugeWithLabels([]string{"block", "gas", "msg_count"}, float32(r.msgCount[t]), labels)
+	}
+
+	for _, key := range sortedKeys(r.storeGas) {
+		labels := []metrics.Label{telemetry.NewLabel(AttributeKeyStoreKey, key)}
+		telemetry.SetGaugeWithLabels([]string{"block", "gas", "store"}, float32(r.storeGas[key]), labels)
+	}
+}
+
+func sortedKeys(m map[string]sdk.Gas) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,116 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// RegisterGRPCServer registers the query services of the BaseApp's
+// GRPCQueryRouter on the given gRPC server. Queries are served against the
+// committed state at the height set in the GRPCBlockHeightHeader of the request
+// metadata, or the latest height if none is set, and the height served is set
+// in the same header of the response.
+func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
+	// Define an interceptor for all gRPC queries: this interceptor will create
+	// a new sdk.Context at the height requested in the metadata, and pass it
+	// into the query handler.
+	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res interface{}, err error) {
+		height, err := grpcQueryHeight(grpcCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx, release, err := app.createQueryContext(height, false)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		defer release()
+
+		// respond with the height the query was served at
+		md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(ctx.BlockHeight(), 10))
+		if err := grpc.SetHeader(grpcCtx, md); err != nil {
+			return nil, err
+		}
+
+		// a panicking query must not bring down the whole gRPC server
+		defer func() {
+			if r := recover(); r != nil {
+				res, err = nil, status.Error(codes.Internal, fmt.Sprintf("panic while handling query: %v", r))
+			}
+		}()
+
+		return handler(sdk.WrapSDKContext(ctx), req)
+	}
+
+	// Loop through all services and methods, add the interceptor, and register
+	// the service.
+	for _, data := range app.grpcQueryRouter.serviceData {
+		desc := *data.serviceDesc
+		desc.Methods = make([]grpc.MethodDesc, len(data.serviceDesc.Methods))
+
+		for i, method := range data.serviceDesc.Methods {
+			methodHandler := method.Handler
+
+			desc.Methods[i] = grpc.MethodDesc{
+				MethodName: method.MethodName,
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+					return methodHandler(srv, ctx, app.grpcDecoder(dec), interceptor)
+				},
+			}
+		}
+
+		server.RegisterService(&desc, data.handler)
+	}
+}
+
+// grpcQueryHeight returns the query height set in the metadata of a gRPC
+// request, or 0 if none is set.
+func grpcQueryHeight(grpcCtx context.Context) (int64, error) {
+	md, ok := metadata.FromIncomingContext(grpcCtx)
+	if !ok {
+		return 0, nil
+	}
+
+	heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(heightHeaders) == 0 {
+		return 0, nil
+	}
+
+	if len(heightHeaders) > 1 {
+		return 0, status.Errorf(codes.InvalidArgument, "multiple %s headers", grpctypes.GRPCBlockHeightHeader)
+	}
+
+	height, err := strconv.ParseInt(heightHeaders[0], 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid height header %q: %s", heightHeaders[0], err)
+	}
+
+	return height, nil
+}
+
+// grpcDecoder wraps the decoder of a gRPC request so that the interfaces packed
+// in the request are unpacked, as they are for ABCI gRPC queries.
+func (app *BaseApp) grpcDecoder(dec func(interface{}) error) func(interface{}) error {
+	return func(i interface{}) error {
+		if err := dec(i); err != nil {
+			return err
+		}
+
+		if anyUnpacker := app.grpcQueryRouter.AnyUnpacker(); anyUnpacker != nil {
+			return types.UnpackInterfaces(i, anyUnpacker)
+		}
+
+		return nil
+	}
+}
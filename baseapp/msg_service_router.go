@@ -0,0 +1,112 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgServiceRouter routes fully-qualified Msg service methods to their handler.
+type MsgServiceRouter struct {
+	interfaceRegistry codectypes.InterfaceRegistry
+	routes            map[string]MsgServiceHandler
+}
+
+var _ gogogrpc.Server = &MsgServiceRouter{}
+
+// NewMsgServiceRouter creates a new MsgServiceRouter.
+func NewMsgServiceRouter() *MsgServiceRouter {
+	return &MsgServiceRouter{
+		routes: map[string]MsgServiceHandler{},
+	}
+}
+
+// MsgServiceHandler defines a function type which handles the request of a Msg
+// service method.
+type MsgServiceHandler = func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error)
+
+// Handler returns the MsgServiceHandler for a given fully-qualified Msg service
+// method name, or nil if not found.
+func (msr *MsgServiceRouter) Handler(methodName string) MsgServiceHandler {
+	return msr.routes[methodName]
+}
+
+// RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
+// service description, handler is an object which implements that gRPC service.
+//
+// For each method of the service, the request type is registered with the
+// interface registry under the fully-qualified method name, so that it can be
+// unpacked from transactions, and a handler is registered which calls the method
+// and returns its proto-marshaled response as result data.
+func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	if msr.interfaceRegistry == nil {
+		panic("cannot register a Msg service without an interface registry")
+	}
+
+	for _, method := range sd.Methods {
+		fqMethod := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		methodHandler := method.Handler
+
+		if _, found := msr.routes[fqMethod]; found {
+			panic(fmt.Sprintf("Msg service method %s has already been registered", fqMethod))
+		}
+
+		// Call the method handler with a decoder which captures the request
+		// type, and stops before the service itself is called.
+		var reqType proto.Message
+		_, _ = methodHandler(nil, context.Background(), func(i interface{}) error {
+			msg, ok := i.(proto.Message)
+			if !ok {
+				panic(fmt.Errorf("request of Msg service method %s is not a proto.Message: %T", fqMethod, i))
+			}
+
+			reqType = msg
+			return errStopDecoding
+		}, nil)
+
+		if _, ok := reqType.(sdk.MsgRequest); !ok {
+			panic(fmt.Errorf("request of Msg service method %s does not implement sdk.MsgRequest: %T", fqMethod, reqType))
+		}
+
+		msr.interfaceRegistry.RegisterCustomTypeURL((*sdk.MsgRequest)(nil), fqMethod, reqType)
+
+		msr.routes[fqMethod] = func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error) {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// The request has already been decoded, so the interceptor replaces
+			// the empty request the method handler decodes into.
+			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, unaryHandler grpc.UnaryHandler) (interface{}, error) {
+				return unaryHandler(goCtx, req)
+			}
+
+			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), noopDecoder, interceptor)
+			if err != nil {
+				return nil, err
+			}
+
+			resMsg, ok := res.(proto.Message)
+			if !ok {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting proto.Message, got %T", res)
+			}
+
+			return sdk.WrapServiceResult(ctx, resMsg, nil)
+		}
+	}
+}
+
+// SetInterfaceRegistry sets the interface registry the request types of Msg
+// services are registered with.
+func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
+	msr.interfaceRegistry = interfaceRegistry
+}
+
+var errStopDecoding = fmt.Errorf("stop decoding")
+
+func noopDecoder(_ interface{}) error { return nil }
@@ -0,0 +1,122 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// bankMsgServer is a bank MsgServer which emits an event for every request it
+// handles.
+type bankMsgServer struct {
+	*banktypes.UnimplementedMsgServer
+}
+
+func (bankMsgServer) Send(goCtx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Amount.IsZero() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "nothing to send")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("send", sdk.NewAttribute("amount", msg.Amount.String())))
+
+	return &banktypes.MsgSendResponse{}, nil
+}
+
+func newTestMsgServiceRouter() (*MsgServiceRouter, codectypes.InterfaceRegistry) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(interfaceRegistry)
+
+	msr := NewMsgServiceRouter()
+	msr.SetInterfaceRegistry(interfaceRegistry)
+	banktypes.RegisterMsgServer(msr, bankMsgServer{})
+
+	return msr, interfaceRegistry
+}
+
+func TestMsgServiceRouter(t *testing.T) {
+	msr, interfaceRegistry := newTestMsgServiceRouter()
+
+	require.NotNil(t, msr.Handler("/cosmos.bank.Msg/Send"))
+	require.NotNil(t, msr.Handler("/cosmos.bank.Msg/MultiSend"))
+	require.Nil(t, msr.Handler("/cosmos.bank.Msg/Burn"))
+
+	// the request types are registered under the method names
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	var req sdk.MsgRequest
+	err = interfaceRegistry.UnpackAny(&codectypes.Any{TypeUrl: "/cosmos.bank.Msg/Send", Value: bz}, &req)
+	require.NoError(t, err)
+	require.Equal(t, msg, req)
+
+	// methods can't be registered twice
+	require.Panics(t, func() { banktypes.RegisterMsgServer(msr, bankMsgServer{}) })
+
+	// services can't be registered without an interface registry
+	require.Panics(t, func() { banktypes.RegisterMsgServer(NewMsgServiceRouter(), bankMsgServer{}) })
+}
+
+func TestMsgServiceRouterHandler(t *testing.T) {
+	msr, _ := newTestMsgServiceRouter()
+	handler := msr.Handler("/cosmos.bank.Msg/Send")
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+
+	var sendRes banktypes.MsgSendResponse
+	require.NoError(t, proto.Unmarshal(res.Data, &sendRes))
+	require.Equal(t, sdk.Events{
+		sdk.NewEvent("send", sdk.NewAttribute("amount", "10stake")),
+	}.ToABCIEvents(), res.Events)
+
+	// the events are collected per request
+	require.Empty(t, ctx.EventManager().Events())
+
+	_, err = handler(ctx, banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil))
+	require.True(t, sdkerrors.ErrInvalidCoins.Is(err))
+
+	// methods which are not implemented return an error
+	_, err = msr.Handler("/cosmos.bank.Msg/MultiSend")(ctx, &banktypes.MsgMultiSend{})
+	require.Error(t, err)
+}
+
+func TestRunMsgsServiceMsg(t *testing.T) {
+	app := setupBaseApp(t)
+	app.MsgServiceRouter().SetInterfaceRegistry(codectypes.NewInterfaceRegistry())
+	banktypes.RegisterMsgServer(app.MsgServiceRouter(), bankMsgServer{})
+
+	app.setDeliverState(abci.Header{Height: 1})
+	ctx := app.getContextForTx(runTxModeDeliver, nil)
+
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	msgs := []sdk.Msg{sdk.ServiceMsg{MethodName: "/cosmos.bank.Msg/Send", Request: msg}}
+
+	res, err := app.runMsgs(ctx, msgs, runTxModeDeliver)
+	require.NoError(t, err)
+
+	var txData sdk.TxData
+	require.NoError(t, proto.Unmarshal(res.Data, &txData))
+	require.Len(t, txData.Data, 1)
+	require.Equal(t, "/cosmos.bank.Msg/Send", txData.Data[0].MsgType)
+
+	var sendRes banktypes.MsgSendResponse
+	require.NoError(t, proto.Unmarshal(txData.Data[0].Data, &sendRes))
+
+	// unknown methods are rejected
+	msgs = []sdk.Msg{sdk.ServiceMsg{MethodName: "/cosmos.bank.Msg/Burn", Request: msg}}
+	_, err = app.runMsgs(ctx, msgs, runTxModeDeliver)
+	require.True(t, sdkerrors.ErrUnknownRequest.Is(err))
+}
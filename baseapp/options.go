@@ -6,7 +6,9 @@ import (
 
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/profilekv"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -49,6 +51,44 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetStoreProfiler provides a BaseApp option function that sets the Profiler
+// recording the accesses made to the stores of the app.
+func SetStoreProfiler(profiler *profilekv.Profiler) func(*BaseApp) {
+	return func(app *BaseApp) { app.setStoreProfiler(profiler) }
+}
+
+// SetQuerySnapshots provides a BaseApp option function that sets the number of
+// recent committed versions kept as read-only snapshots queries are served
+// from. Zero disables the snapshots.
+func SetQuerySnapshots(snapshots int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setQuerySnapshots(snapshots) }
+}
+
+// SetSnapshotStore sets the snapshot store.
+func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
+}
+
+// SetSnapshotInterval sets the snapshot interval.
+func SetSnapshotInterval(interval uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotInterval(interval) }
+}
+
+// SetSnapshotKeepRecent sets the recent snapshots to keep.
+func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotKeepRecent(keepRecent) }
+}
+
+// SetCheckTxWorkers sets the number of workers used by CheckTxBatch.
+func SetCheckTxWorkers(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetCheckTxWorkers(workers) }
+}
+
+// SetBlockGasReport enables or disables the block gas report.
+func SetBlockGasReport(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetBlockGasReport(enabled) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -123,6 +163,14 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+func (app *BaseApp) SetPostHandler(ph sdk.PostHandler) {
+	if app.sealed {
+		panic("SetPostHandler() on sealed BaseApp")
+	}
+
+	app.postHandler = ph
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -169,3 +217,76 @@ func (app *BaseApp) SetRouter(router sdk.Router) {
 	}
 	app.router = router
 }
+
+// SetSnapshotStore sets the snapshot store.
+func (app *BaseApp) SetSnapshotStore(snapshotStore *snapshots.Store) {
+	if app.sealed {
+		panic("SetSnapshotStore() on sealed BaseApp")
+	}
+
+	if snapshotStore == nil {
+		app.snapshotManager = nil
+		return
+	}
+
+	app.snapshotManager = snapshots.NewManager(snapshotStore, app.cms)
+}
+
+// SetSnapshotInterval sets the snapshot interval.
+func (app *BaseApp) SetSnapshotInterval(snapshotInterval uint64) {
+	if app.sealed {
+		panic("SetSnapshotInterval() on sealed BaseApp")
+	}
+
+	app.snapshotInterval = snapshotInterval
+}
+
+// SetSnapshotKeepRecent sets the number of recent snapshots to keep.
+func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
+	if app.sealed {
+		panic("SetSnapshotKeepRecent() on sealed BaseApp")
+	}
+
+	app.snapshotKeepRecent = snapshotKeepRecent
+}
+
+// SetStreamingService registers a streaming service with the BaseApp. Its
+// listeners are added to the DeliverTx state and its ABCI hooks are called
+// after every BeginBlock, DeliverTx and EndBlock.
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	if app.sealed {
+		panic("SetStreamingService() on sealed BaseApp")
+	}
+
+	if app.streamingListeners == nil {
+		app.streamingListeners = make(map[sdk.StoreKey][]sdk.WriteListener)
+	}
+
+	for key, listeners := range s.Listeners() {
+		app.streamingListeners[key] = append(app.streamingListeners[key], listeners...)
+	}
+
+	app.abciListeners = append(app.abciListeners, s)
+}
+
+// SetCheckTxWorkers sets the number of workers running transactions
+// concurrently in CheckTxBatch. With less than two workers, transactions are
+// run serially.
+func (app *BaseApp) SetCheckTxWorkers(workers int) {
+	if app.sealed {
+		panic("SetCheckTxWorkers() on sealed BaseApp")
+	}
+
+	app.checkTxWorkers = workers
+}
+
+// SetBlockGasReport enables or disables the block gas report. When enabled, the
+// gas consumed by the transactions of each block is summarized by message type
+// and by store key, and emitted in EndBlock both as events and as telemetry.
+func (app *BaseApp) SetBlockGasReport(enabled bool) {
+	if app.sealed {
+		panic("SetBlockGasReport() on sealed BaseApp")
+	}
+
+	app.blockGasReportEnabled = enabled
+}
@@ -0,0 +1,159 @@
+package baseapp
+
+import (
+	"sync/atomic"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+// querySnapshot is a read-only view of a committed version of the multi-store,
+// along with the header of the block that committed it.
+type querySnapshot struct {
+	store  *rootmulti.ReadOnlyStore
+	header abci.Header
+
+	// readers is the number of queries reading the snapshot.
+	readers *int64
+}
+
+func newQuerySnapshot(store *rootmulti.ReadOnlyStore, header abci.Header) querySnapshot {
+	return querySnapshot{store: store, header: header, readers: new(int64)}
+}
+
+// queryPool holds the snapshots of the most recent committed versions of the
+// multi-store, from which queries are served concurrently without reading the
+// check state. The snapshots are replaced as a whole on each Commit, so that
+// queries never hold a lock that Commit would wait on.
+//
+// The snapshots of the versions being pruned are evicted before they are
+// pruned, and the pruning of those still being read is deferred.
+type queryPool struct {
+	size      int
+	snapshots atomic.Value // []querySnapshot, from the oldest to the latest
+
+	// retired holds the snapshots removed from the pool which may still be read,
+	// by version.
+	retired map[int64]querySnapshot
+}
+
+func newQueryPool(size int) *queryPool {
+	qp := &queryPool{size: size, retired: make(map[int64]querySnapshot)}
+	qp.snapshots.Store([]querySnapshot(nil))
+
+	return qp
+}
+
+func (qp *queryPool) load() []querySnapshot {
+	return qp.snapshots.Load().([]querySnapshot)
+}
+
+// latest returns the snapshot of the latest committed version, if any.
+func (qp *queryPool) latest() (querySnapshot, bool) {
+	snapshots := qp.load()
+	if len(snapshots) == 0 {
+		return querySnapshot{}, false
+	}
+
+	return snapshots[len(snapshots)-1], true
+}
+
+// get returns the snapshot of the given version, if it is kept in the pool.
+func (qp *queryPool) get(version int64) (querySnapshot, bool) {
+	for _, snapshot := range qp.load() {
+		if snapshot.store.Version() == version {
+			return snapshot, true
+		}
+	}
+
+	return querySnapshot{}, false
+}
+
+// acquire returns the snapshot of the given version, if it is kept in the pool,
+// along with a function to be called once the query is done reading it.
+func (qp *queryPool) acquire(version int64) (querySnapshot, func(), bool) {
+	snapshot, ok := qp.get(version)
+	if !ok {
+		return querySnapshot{}, nil, false
+	}
+
+	atomic.AddInt64(snapshot.readers, 1)
+	release := func() { atomic.AddInt64(snapshot.readers, -1) }
+
+	// the snapshot may have been evicted before it was acquired, in which case
+	// its pruning was not deferred
+	if _, ok := qp.get(version); !ok {
+		release()
+		return querySnapshot{}, nil, false
+	}
+
+	return snapshot, release, true
+}
+
+// add adds the snapshot of a newly committed version to the pool, evicting the
+// oldest snapshots beyond the size of the pool.
+//
+// NOTE: add and evict must only be called from Commit, as they are not safe to
+// call concurrently with each other.
+func (qp *queryPool) add(snapshot querySnapshot) {
+	old := qp.load()
+	if len(old) >= qp.size {
+		qp.retire(old[:len(old)-qp.size+1])
+		old = old[len(old)-qp.size+1:]
+	}
+
+	snapshots := make([]querySnapshot, 0, len(old)+1)
+	snapshots = append(snapshots, old...)
+
+	qp.snapshots.Store(append(snapshots, snapshot))
+
+	// the retired snapshots no longer being read cannot be acquired anymore
+	for version, s := range qp.retired {
+		if atomic.LoadInt64(s.readers) == 0 {
+			delete(qp.retired, version)
+		}
+	}
+}
+
+// evict removes the snapshots of the given versions, which are about to be
+// pruned, from the pool. It returns the versions still being read, whose
+// pruning must be deferred.
+func (qp *queryPool) evict(versions []int64) (deferred []int64) {
+	evicted := make(map[int64]bool, len(versions))
+	for _, version := range versions {
+		evicted[version] = true
+	}
+
+	old := qp.load()
+
+	snapshots := make([]querySnapshot, 0, len(old))
+	for _, s := range old {
+		if evicted[s.store.Version()] {
+			qp.retire([]querySnapshot{s})
+		} else {
+			snapshots = append(snapshots, s)
+		}
+	}
+
+	qp.snapshots.Store(snapshots)
+
+	// the readers are counted after the snapshots are removed from the pool, so
+	// that a snapshot acquired afterwards is released without being read
+	for _, version := range versions {
+		if s, ok := qp.retired[version]; ok && atomic.LoadInt64(s.readers) > 0 {
+			deferred = append(deferred, version)
+			continue
+		}
+
+		delete(qp.retired, version)
+	}
+
+	return deferred
+}
+
+func (qp *queryPool) retire(snapshots []querySnapshot) {
+	for _, s := range snapshots {
+		qp.retired[s.store.Version()] = s
+	}
+}
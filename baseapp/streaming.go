@@ -0,0 +1,33 @@
+package baseapp
+
+import (
+	"io"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIListener is the interface used to hook into the ABCI message processing
+// of the BaseApp. The hooks are called after the corresponding ABCI message has
+// been processed, in the order the messages are received.
+type ABCIListener interface {
+	// ListenBeginBlock updates the streaming service with the latest BeginBlock messages
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenEndBlock updates the streaming service with the latest EndBlock messages
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenDeliverTx updates the streaming service with the latest DeliverTx messages
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+}
+
+// StreamingService is the interface for streaming state changes out of the
+// BaseApp. Its WriteListeners are registered on the DeliverTx state, so they
+// receive every Set and Delete applied during BeginBlock, DeliverTx and EndBlock
+// before the corresponding ABCIListener hook is called.
+type StreamingService interface {
+	ABCIListener
+	io.Closer
+
+	// Listeners returns the streaming service's listeners for the BaseApp to register
+	Listeners() map[sdk.StoreKey][]sdk.WriteListener
+}
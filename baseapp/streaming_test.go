@@ -0,0 +1,104 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockStreamingService records the ABCI messages and the number of state
+// changes which preceded each of them.
+type mockStreamingService struct {
+	keys     []sdk.StoreKey
+	writes   int
+	messages []string
+}
+
+func (m *mockStreamingService) OnWrite(storeKey sdk.StoreKey, key []byte, value []byte, delete bool) error {
+	m.writes++
+	return nil
+}
+
+func (m *mockStreamingService) record(msg string) error {
+	m.messages = append(m.messages, fmt.Sprintf("%s:%d", msg, m.writes))
+	m.writes = 0
+	return nil
+}
+
+func (m *mockStreamingService) Listeners() map[sdk.StoreKey][]sdk.WriteListener {
+	listeners := make(map[sdk.StoreKey][]sdk.WriteListener)
+	for _, key := range m.keys {
+		listeners[key] = []sdk.WriteListener{m}
+	}
+	return listeners
+}
+
+func (m *mockStreamingService) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	return m.record("begin")
+}
+
+func (m *mockStreamingService) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	return m.record("end")
+}
+
+func (m *mockStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if !res.IsOK() {
+		return m.record("tx(failed)")
+	}
+	return m.record("tx")
+}
+
+func (m *mockStreamingService) Close() error { return nil }
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	service := &mockStreamingService{keys: []sdk.StoreKey{capKey1}}
+	streamingOpt := func(bapp *BaseApp) { bapp.SetStreamingService(service) }
+
+	app := setupBaseApp(t, anteOpt, routerOpt, streamingOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	// CheckTx writes to the check state must not be streamed
+	checkTx := newTxCounter(0, 0)
+	checkTxBytes, err := codec.MarshalBinaryBare(checkTx)
+	require.NoError(t, err)
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: checkTxBytes}).IsOK())
+	require.Zero(t, service.writes)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+
+	// a successful tx writes the ante and the handler counters
+	tx := newTxCounter(0, 0)
+	txBytes, err := codec.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+	require.True(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+
+	// a tx failing in its handler only writes the ante counter
+	tx = newTxCounter(1, 1)
+	tx.setFailOnHandler(true)
+	txBytes, err = codec.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+	require.False(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	require.Equal(t, []string{"begin:0", "tx:2", "tx(failed):1", "end:0"}, service.messages)
+	require.Zero(t, service.writes, "committing should not stream any writes")
+}
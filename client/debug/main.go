@@ -26,6 +26,8 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(PubkeyCmd())
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
+	cmd.AddCommand(StoreStatsCmd())
+	cmd.AddCommand(VerifyStoreCmd())
 
 	return cmd
 }
@@ -0,0 +1,217 @@
+package debug
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/profilekv"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const flagPrefixLength = "prefix-length"
+
+// StoreStatsCmd returns the command computing the number and size of the keys
+// of the application stores, by store key and key prefix, from the application
+// database of a stopped node.
+func StoreStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-stats",
+		Short: "Compute the number and size of the keys of the application stores",
+		Long: fmt.Sprintf(`Compute the number and size of the keys of the application stores at a committed
+height, in total and by key prefix, from the application database of a stopped node.
+
+Example:
+$ %s debug store-stats --height 1000 --prefix-length 2 --output json
+			`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.ReadPersistentCommandFlags(client.GetClientContextFromCmd(cmd), cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			height, _ := cmd.Flags().GetInt64(flags.FlagHeight)
+			prefixLength, _ := cmd.Flags().GetInt(flagPrefixLength)
+
+			if prefixLength < 0 {
+				return fmt.Errorf("invalid prefix length %d", prefixLength)
+			}
+
+			db, err := openDataDB(clientCtx.HomeDir, "application")
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			profiler := profilekv.NewProfiler(prefixLength)
+
+			height, err = rootmulti.ProfileVersion(db, height, profiler)
+			if err != nil {
+				return err
+			}
+
+			report := profiler.Report()
+			report.Height = height
+
+			if clientCtx.OutputFormat == "json" {
+				return report.WriteJSON(cmd.OutOrStdout())
+			}
+
+			return report.WriteTable(cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().Int64(flags.FlagHeight, 0, "Height of the state to compute statistics of (0 for the latest)")
+	cmd.Flags().Int(flagPrefixLength, profilekv.DefaultPrefixLength, "Length in bytes of the key prefixes to group keys by")
+	cmd.Flags().StringP(cli.OutputFlag, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// VerifyStoreCmd returns the command verifying the integrity of the application
+// stores committed at a height, from the application database of a stopped
+// node.
+func VerifyStoreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-store [height]",
+		Short: "Verify the integrity of the application stores committed at a height",
+		Long: fmt.Sprintf(`Verify the integrity of the application stores committed at a height, or at the
+latest height if none is given, from the application database of a stopped node.
+
+The tree of each IAVL store is walked through, and the hash of each of its nodes
+recomputed and compared with the hash it is referenced by. The root hash of each
+store is compared with the hash committed in the commit info of the height, and
+the resulting app hash with the one recorded by Tendermint for the height, if
+the Tendermint block store or state holds it. The store key and the path of any
+corrupted node are reported, the path being the sequence of branches, L for left
+and R for right, taken from the root of the store to reach the node.
+
+Example:
+$ %s debug verify-store 1000
+			`, version.AppName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			var height int64
+			if len(args) > 0 {
+				h, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil || h <= 0 {
+					return fmt.Errorf("invalid height %s", args[0])
+				}
+
+				height = h
+			}
+
+			db, err := openDataDB(clientCtx.HomeDir, "application")
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			res, err := rootmulti.VerifyVersion(db, height)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "height %d\n", res.Version)
+
+			for _, store := range res.Stores {
+				switch {
+				case store.Skipped:
+					fmt.Fprintf(out, "store %s: skipped, not an IAVL store\n", store.Name)
+
+				case len(store.Corruptions) == 0:
+					fmt.Fprintf(out, "store %s: OK, %d nodes\n", store.Name, store.Nodes)
+
+				default:
+					fmt.Fprintf(out, "store %s: CORRUPTED, %d nodes, %d corrupted\n", store.Name, store.Nodes, len(store.Corruptions))
+
+					for _, corruption := range store.Corruptions {
+						fmt.Fprintf(out, "  %s\n", corruption)
+					}
+				}
+			}
+
+			appHashMatches := checkTendermintAppHash(out, clientCtx.HomeDir, res.Version, res.AppHash)
+
+			if res.Corrupted() || !appHashMatches {
+				return errors.New("the application stores are corrupted")
+			}
+
+			return nil
+		},
+	}
+}
+
+// checkTendermintAppHash compares the given app hash of a height with the one
+// recorded by Tendermint, which is held in the header of the next block, or in
+// the state if the height is the latest one. It returns false if they do not
+// match, and true if they do or if Tendermint does not hold the app hash.
+func checkTendermintAppHash(out io.Writer, home string, height int64, appHash []byte) bool {
+	var (
+		tmAppHash []byte
+		found     bool
+	)
+
+	blockStoreDB, err := openDataDB(home, "blockstore")
+	if err == nil {
+		defer blockStoreDB.Close()
+
+		if meta := tmstore.NewBlockStore(blockStoreDB).LoadBlockMeta(height + 1); meta != nil {
+			tmAppHash, found = meta.Header.AppHash, true
+		}
+	}
+
+	if !found {
+		stateDB, err := openDataDB(home, "state")
+		if err == nil {
+			defer stateDB.Close()
+
+			if state := sm.LoadState(stateDB); state.LastBlockHeight == height {
+				tmAppHash, found = state.AppHash, true
+			}
+		}
+	}
+
+	switch {
+	case !found:
+		fmt.Fprintf(out, "app hash %X: not checked, Tendermint does not hold the app hash of height %d\n", appHash, height)
+		return true
+
+	case !bytes.Equal(tmAppHash, appHash):
+		fmt.Fprintf(out, "app hash %X: MISMATCH, Tendermint has %X\n", appHash, tmAppHash)
+		return false
+
+	default:
+		fmt.Fprintf(out, "app hash %X: OK\n", appHash)
+		return true
+	}
+}
+
+// openDataDB opens the database of the given name of the data directory of a
+// node, returning an error if it does not exist.
+func openDataDB(home, name string) (dbm.DB, error) {
+	dataDir := filepath.Join(home, "data")
+	if _, err := os.Stat(filepath.Join(dataDir, name+".db")); err != nil {
+		return nil, fmt.Errorf("failed to open %s database: %w", name, err)
+	}
+
+	return sdk.NewLevelDB(name, dataDir)
+}
@@ -3,30 +3,54 @@ package client
 import (
 	gocontext "context"
 	"fmt"
+	"strconv"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/encoding"
 	"google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 )
 
 var _ gogogrpc.ClientConn = Context{}
 
 var protoCodec = encoding.GetCodec(proto.Name)
 
-// Invoke implements the grpc ClientConn.Invoke method
-func (ctx Context) Invoke(_ gocontext.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+// Invoke implements the grpc ClientConn.Invoke method. The query height is
+// taken from the GRPCBlockHeightHeader of the outgoing metadata, if set, and the
+// height the query was served at is returned in the same header to grpc.Header
+// call options.
+func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
 	reqBz, err := protoCodec.Marshal(args)
 	if err != nil {
 		return err
 	}
-	resBz, _, err := ctx.QueryWithData(method, reqBz)
+
+	if md, ok := metadata.FromOutgoingContext(grpcCtx); ok {
+		if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+			height, err := strconv.ParseInt(heights[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height header %q: %w", heights[0], err)
+			}
+
+			ctx = ctx.WithHeight(height)
+		}
+	}
+
+	resBz, height, err := ctx.QueryWithData(method, reqBz)
 	if err != nil {
 		return err
 	}
 
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		}
+	}
+
 	err = protoCodec.Unmarshal(resBz, reply)
 	if err != nil {
 		return err
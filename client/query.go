@@ -98,7 +98,7 @@ func (ctx Context) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, error)
 		return abci.ResponseQuery{}, errors.New(result.Response.Log)
 	}
 
-	// data from trusted node or subspace query doesn't need verification
+	// data from trusted node or queries other than store queries don't need verification
 	if !opts.Prove || !isQueryStoreWithProof(req.Path) {
 		return result.Response, nil
 	}
@@ -157,15 +157,24 @@ func (ctx Context) verifyProof(queryPath string, resp abci.ResponseQuery) error
 		return err
 	}
 
-	// TODO: Instead of reconstructing, stash on Context field?
-	prt := rootmulti.DefaultProofRuntime()
-
 	// TODO: Better convention for path?
-	storeName, err := parseQueryStorePath(queryPath)
+	storeName, subpath, err := parseQueryStorePath(queryPath)
 	if err != nil {
 		return err
 	}
 
+	if subpath == "subspace" {
+		var kvs []sdk.KVPair
+		if err := subspaceCdc.UnmarshalBinaryBare(resp.Value, &kvs); err != nil {
+			return errors.Wrap(err, "failed to decode subspace")
+		}
+
+		return VerifySubspace(resp.Proof, commit.Header.AppHash, storeName, resp.Key, kvs)
+	}
+
+	// TODO: Instead of reconstructing, stash on Context field?
+	prt := rootmulti.DefaultProofRuntime()
+
 	kp := merkle.KeyPath{}
 	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
 	kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
@@ -194,7 +203,8 @@ func (ctx Context) queryStore(key tmbytes.HexBytes, storeName, endPath string) (
 }
 
 // isQueryStoreWithProof expects a format like /<queryType>/<storeName>/<subpath>
-// queryType must be "store" and subpath must be "key" to require a proof.
+// queryType must be "store" and subpath must be "key" or "subspace" to require a
+// proof.
 func isQueryStoreWithProof(path string) bool {
 	if !strings.HasPrefix(path, "/") {
 		return false
@@ -214,22 +224,23 @@ func isQueryStoreWithProof(path string) bool {
 	return false
 }
 
-// parseQueryStorePath expects a format like /store/<storeName>/key.
-func parseQueryStorePath(path string) (storeName string, err error) {
+// parseQueryStorePath expects a format like /store/<storeName>/key or
+// /store/<storeName>/subspace.
+func parseQueryStorePath(path string) (storeName, subpath string, err error) {
 	if !strings.HasPrefix(path, "/") {
-		return "", errors.New("expected path to start with /")
+		return "", "", errors.New("expected path to start with /")
 	}
 
 	paths := strings.SplitN(path[1:], "/", 3)
 
 	switch {
 	case len(paths) != 3:
-		return "", errors.New("expected format like /store/<storeName>/key")
+		return "", "", errors.New("expected format like /store/<storeName>/key")
 	case paths[0] != "store":
-		return "", errors.New("expected format like /store/<storeName>/key")
-	case paths[2] != "key":
-		return "", errors.New("expected format like /store/<storeName>/key")
+		return "", "", errors.New("expected format like /store/<storeName>/key")
+	case paths[2] != "key" && paths[2] != "subspace":
+		return "", "", errors.New("expected format like /store/<storeName>/key")
 	}
 
-	return paths[1], nil
+	return paths[1], paths[2], nil
 }
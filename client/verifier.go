@@ -4,10 +4,15 @@ import (
 	"path/filepath"
 
 	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/libs/log"
 	tmlite "github.com/tendermint/tendermint/lite"
 	tmliteproxy "github.com/tendermint/tendermint/lite/proxy"
 	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -17,6 +22,9 @@ const (
 	DefaultVerifierCacheSize = 10
 )
 
+// subspaceCdc decodes the key-value pairs returned by subspace queries.
+var subspaceCdc = codec.New()
+
 // CreateVerifier returns a Tendermint verifier from a Context object and
 // cache size. An error is returned if the Context is missing required values
 // or if the verifier could not be created. A Context must at the very least
@@ -54,3 +62,31 @@ func CreateVerifier(ctx Context, cacheSize int) (tmlite.Verifier, error) {
 		client, log.NewNopLogger(), cacheSize,
 	)
 }
+
+// VerifySubspace verifies the proof returned by a subspace query of the store
+// with the given name, i.e. that the given key-value pairs are exactly the pairs
+// of the store whose keys have the given prefix, with none omitted, in the state
+// committed to by the given app hash. The pairs must be in ascending key order,
+// as returned by the query. The app hash of the state at height H is found in
+// the header of height H+1, which light clients are expected to have verified.
+func VerifySubspace(proof *merkle.Proof, appHash []byte, storeName string, subspace []byte, kvs []sdk.KVPair) error {
+	if proof == nil {
+		return errors.New("missing subspace proof")
+	}
+
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(subspace, merkle.KeyEncodingURL)
+
+	args := make([][]byte, 0, 2*len(kvs))
+	for _, kv := range kvs {
+		args = append(args, kv.Key, kv.Value)
+	}
+
+	prt := rootmulti.DefaultProofRuntime()
+	if err := prt.Verify(proof, appHash, kp.String(), args); err != nil {
+		return errors.Wrap(err, "failed to prove merkle proof")
+	}
+
+	return nil
+}
@@ -4,9 +4,15 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestCreateVerifier(t *testing.T) {
@@ -35,3 +41,29 @@ func TestCreateVerifier(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifySubspace(t *testing.T) {
+	key := sdk.NewKVStoreKey("bank")
+	ms := rootmulti.NewStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	store := ms.GetKVStore(key)
+	store.Set([]byte("balances/addr1/atom"), []byte("1"))
+	store.Set([]byte("balances/addr1/stake"), []byte("2"))
+	store.Set([]byte("balances/addr2/atom"), []byte("3"))
+	cid := ms.Commit()
+
+	subspace := []byte("balances/addr1/")
+	res := ms.Query(abci.RequestQuery{Path: "/bank/subspace", Data: subspace, Height: cid.Version, Prove: true})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+
+	var kvs []sdk.KVPair
+	require.NoError(t, codec.New().UnmarshalBinaryBare(res.Value, &kvs))
+	require.Len(t, kvs, 2)
+
+	require.NoError(t, client.VerifySubspace(res.Proof, cid.Hash, "bank", subspace, kvs))
+	require.Error(t, client.VerifySubspace(res.Proof, cid.Hash, "bank", subspace, kvs[1:]))
+	require.Error(t, client.VerifySubspace(res.Proof, cid.Hash, "staking", subspace, kvs))
+	require.Error(t, client.VerifySubspace(nil, cid.Hash, "bank", subspace, kvs))
+}
@@ -42,6 +42,15 @@ type InterfaceRegistry interface {
 	// Ex:
 	//  registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSend{}, &MsgMultiSend{})
 	RegisterImplementations(iface interface{}, impls ...proto.Message)
+
+	// RegisterCustomTypeURL registers impl as a concrete implementation of the
+	// interface iface under the given type URL, rather than the one derived from
+	// its message name. This is used to unpack the requests of Msg service
+	// methods, which are packed using the method name as type URL.
+	//
+	// Ex:
+	//  registry.RegisterCustomTypeURL((*sdk.MsgRequest)(nil), "/cosmos.bank.Msg/Send", &MsgSend{})
+	RegisterCustomTypeURL(iface interface{}, typeURL string, impl proto.Message)
 }
 
 // UnpackInterfacesMessage is meant to extend protobuf types (which implement
@@ -93,22 +102,36 @@ func (registry *interfaceRegistry) RegisterInterface(protoName string, iface int
 }
 
 func (registry *interfaceRegistry) RegisterImplementations(iface interface{}, impls ...proto.Message) {
+	ityp := reflect.TypeOf(iface).Elem()
+	if _, found := registry.interfaceImpls[ityp]; !found {
+		registry.interfaceImpls[ityp] = map[string]reflect.Type{}
+	}
+
+	for _, impl := range impls {
+		registry.registerImpl(iface, "/"+proto.MessageName(impl), impl)
+	}
+}
+
+func (registry *interfaceRegistry) RegisterCustomTypeURL(iface interface{}, typeURL string, impl proto.Message) {
+	registry.registerImpl(iface, typeURL, impl)
+}
+
+// registerImpl registers impl as a concrete implementation of the interface
+// iface under the given type URL.
+func (registry *interfaceRegistry) registerImpl(iface interface{}, typeURL string, impl proto.Message) {
 	ityp := reflect.TypeOf(iface).Elem()
 	imap, found := registry.interfaceImpls[ityp]
 	if !found {
 		imap = map[string]reflect.Type{}
+		registry.interfaceImpls[ityp] = imap
 	}
 
-	for _, impl := range impls {
-		implType := reflect.TypeOf(impl)
-		if !implType.AssignableTo(ityp) {
-			panic(fmt.Errorf("type %T doesn't actually implement interface %+v", impl, ityp))
-		}
-
-		imap["/"+proto.MessageName(impl)] = implType
+	implType := reflect.TypeOf(impl)
+	if !implType.AssignableTo(ityp) {
+		panic(fmt.Errorf("type %T doesn't actually implement interface %+v", impl, ityp))
 	}
 
-	registry.interfaceImpls[ityp] = imap
+	imap[typeURL] = implType
 }
 
 func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error {
@@ -13,6 +13,9 @@ import (
 
 const (
 	defaultMinGasPrices = ""
+
+	// DefaultGRPCAddress is the default address the gRPC server binds to.
+	DefaultGRPCAddress = "0.0.0.0:9090"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -40,8 +43,26 @@ type BaseConfig struct {
 	// Note: Commitment of state will be attempted on the corresponding block.
 	HaltTime uint64 `mapstructure:"halt-time"`
 
+	// HaltExportPath contains a file path to which the state of the application
+	// is exported as a genesis file once the node halts per HaltHeight or
+	// HaltTime. No state is exported if it is empty.
+	HaltExportPath string `mapstructure:"halt-export-path"`
+
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
+
+	// InterBlockWriteCache makes the inter-block cache hold the writes made to
+	// the stores until they are committed, instead of writing them through.
+	InterBlockWriteCache bool `mapstructure:"inter-block-write-cache"`
+
+	// QuerySnapshots is the number of recent committed heights kept in memory as
+	// read-only snapshots from which queries are served. Queries are served from
+	// the multi-store directly if it is zero.
+	QuerySnapshots uint `mapstructure:"query-snapshots"`
+
+	// StoreProfile enables recording the accesses made to the stores by key
+	// prefix, which are reported by the /app/store-profile query.
+	StoreProfile bool `mapstructure:"store-profile"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -75,6 +96,26 @@ type APIConfig struct {
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
 }
 
+// GRPCConfig defines the gRPC server configuration.
+type GRPCConfig struct {
+	// Enable defines if the gRPC server should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the gRPC server address to bind to.
+	Address string `mapstructure:"address"`
+}
+
+// StateSyncConfig defines the state sync snapshot configuration.
+type StateSyncConfig struct {
+	// SnapshotInterval sets the interval at which state sync snapshots are taken.
+	// 0 disables snapshots. Must be a multiple of PruningKeepEvery.
+	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
+
+	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
+	// 0 keeps all snapshots.
+	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -82,6 +123,8 @@ type Config struct {
 	// Telemetry defines the application telemetry configuration
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
 	API       APIConfig        `mapstructure:"api"`
+	GRPC      GRPCConfig       `mapstructure:"grpc"`
+	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -117,6 +160,7 @@ func DefaultConfig() *Config {
 		BaseConfig: BaseConfig{
 			MinGasPrices:      defaultMinGasPrices,
 			InterBlockCache:   true,
+			QuerySnapshots:    1,
 			Pruning:           storetypes.PruningOptionDefault,
 			PruningKeepRecent: "0",
 			PruningKeepEvery:  "0",
@@ -134,6 +178,14 @@ func DefaultConfig() *Config {
 			RPCReadTimeout:     10,
 			RPCMaxBodyBytes:    1000000,
 		},
+		GRPC: GRPCConfig{
+			Enable:  false,
+			Address: DefaultGRPCAddress,
+		},
+		StateSync: StateSyncConfig{
+			SnapshotInterval:   0,
+			SnapshotKeepRecent: 2,
+		},
 	}
 }
 
@@ -150,14 +202,18 @@ func GetConfig(v *viper.Viper) Config {
 
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      v.GetString("minimum-gas-prices"),
-			InterBlockCache:   v.GetBool("inter-block-cache"),
-			Pruning:           v.GetString("pruning"),
-			PruningKeepRecent: v.GetString("pruning-keep-recent"),
-			PruningKeepEvery:  v.GetString("pruning-keep-every"),
-			PruningInterval:   v.GetString("pruning-interval"),
-			HaltHeight:        v.GetUint64("halt-height"),
-			HaltTime:          v.GetUint64("halt-time"),
+			MinGasPrices:         v.GetString("minimum-gas-prices"),
+			InterBlockCache:      v.GetBool("inter-block-cache"),
+			InterBlockWriteCache: v.GetBool("inter-block-write-cache"),
+			QuerySnapshots:       v.GetUint("query-snapshots"),
+			StoreProfile:         v.GetBool("store-profile"),
+			Pruning:              v.GetString("pruning"),
+			PruningKeepRecent:    v.GetString("pruning-keep-recent"),
+			PruningKeepEvery:     v.GetString("pruning-keep-every"),
+			PruningInterval:      v.GetString("pruning-interval"),
+			HaltHeight:           v.GetUint64("halt-height"),
+			HaltTime:             v.GetUint64("halt-time"),
+			HaltExportPath:       v.GetString("halt-export-path"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -177,5 +233,13 @@ func GetConfig(v *viper.Viper) Config {
 			RPCMaxBodyBytes:    v.GetUint("api.rpc-max-body-bytes"),
 			EnableUnsafeCORS:   v.GetBool("api.enabled-unsafe-cors"),
 		},
+		GRPC: GRPCConfig{
+			Enable:  v.GetBool("grpc.enable"),
+			Address: v.GetString("grpc.address"),
+		},
+		StateSync: StateSyncConfig{
+			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
+			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+		},
 	}
 }
@@ -44,9 +44,30 @@ halt-height = {{ .BaseConfig.HaltHeight }}
 # Note: Commitment of state will be attempted on the corresponding block.
 halt-time = {{ .BaseConfig.HaltTime }}
 
+# HaltExportPath contains a file path to which the state of the application is
+# exported as a genesis file once the node halts per halt-height or halt-time.
+# No state is exported if it is empty.
+halt-export-path = "{{ .BaseConfig.HaltExportPath }}"
+
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# InterBlockWriteCache makes the inter-block cache hold the writes made to the
+# stores until they are committed, instead of writing them through. It has no
+# effect unless inter-block-cache is enabled.
+inter-block-write-cache = {{ .BaseConfig.InterBlockWriteCache }}
+
+# QuerySnapshots is the number of recent committed heights kept in memory as
+# read-only snapshots from which gRPC and ABCI queries are served, so that
+# queries neither read the check state nor block Commit. Queries are served from
+# the multi-store directly if it is 0.
+query-snapshots = {{ .BaseConfig.QuerySnapshots }}
+
+# StoreProfile enables recording the number and size of the reads, writes,
+# deletes and iterators made to the stores by key prefix, which are reported as
+# JSON by the /app/store-profile ABCI query.
+store-profile = {{ .BaseConfig.StoreProfile }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -111,6 +132,33 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk)
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
+
+###############################################################################
+###                           gRPC Configuration                            ###
+###############################################################################
+
+[grpc]
+
+# Enable defines if the gRPC server should be enabled.
+enable = {{ .GRPC.Enable }}
+
+# Address defines the gRPC server address to bind to.
+address = "{{ .GRPC.Address }}"
+
+###############################################################################
+###                        State Sync Configuration                         ###
+###############################################################################
+
+# State sync snapshots allow other nodes to rapidly join the network without replaying historical
+# blocks, instead downloading and applying a snapshot of the application state at a given height.
+[state-sync]
+
+# snapshot-interval specifies the block interval at which local state sync snapshots are
+# taken (0 to disable). Must be a multiple of pruning-keep-every.
+snapshot-interval = {{ .StateSync.SnapshotInterval }}
+
+# snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
+snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 `
 
 var configTemplate *template.Template
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/gogo/protobuf/grpc"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -34,6 +35,14 @@ type (
 		abci.Application
 
 		RegisterAPIRoutes(*api.Server)
+
+		// RegisterGRPCServer registers the application's gRPC query services on
+		// the given gRPC server.
+		RegisterGRPCServer(grpc.Server)
+
+		// Halted returns a channel which is closed once the application has
+		// committed the block at which it is configured to halt.
+		Halted() <-chan struct{}
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
@@ -4,11 +4,13 @@ package server
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 
 	"github.com/spf13/cobra"
 	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -66,39 +68,12 @@ func ExportCmd(appExporter AppExporter) *cobra.Command {
 			forZeroHeight, _ := cmd.Flags().GetBool(flagForZeroHeight)
 			jailWhiteList, _ := cmd.Flags().GetStringSlice(flagJailWhitelist)
 
-			appState, validators, cp, err := appExporter(serverCtx.Logger, db, traceWriter, height, forZeroHeight, jailWhiteList)
-			if err != nil {
-				return fmt.Errorf("error exporting state: %v", err)
-			}
-
-			doc, err := tmtypes.GenesisDocFromFile(serverCtx.Config.GenesisFile())
+			encoded, err := exportGenesisDoc(serverCtx, cdc, appExporter, db, traceWriter, height, forZeroHeight, jailWhiteList)
 			if err != nil {
 				return err
 			}
 
-			doc.AppState = appState
-			doc.Validators = validators
-			doc.ConsensusParams = &tmtypes.ConsensusParams{
-				Block: tmtypes.BlockParams{
-					MaxBytes:   cp.Block.MaxBytes,
-					MaxGas:     cp.Block.MaxGas,
-					TimeIotaMs: doc.ConsensusParams.Block.TimeIotaMs,
-				},
-				Evidence: tmtypes.EvidenceParams{
-					MaxAgeNumBlocks: cp.Evidence.MaxAgeNumBlocks,
-					MaxAgeDuration:  cp.Evidence.MaxAgeDuration,
-				},
-				Validator: tmtypes.ValidatorParams{
-					PubKeyTypes: cp.Validator.PubKeyTypes,
-				},
-			}
-
-			encoded, err := codec.MarshalJSONIndent(cdc, doc)
-			if err != nil {
-				return err
-			}
-
-			cmd.Println(string(sdk.MustSortJSON(encoded)))
+			cmd.Println(string(encoded))
 			return nil
 		},
 	}
@@ -110,3 +85,45 @@ func ExportCmd(appExporter AppExporter) *cobra.Command {
 
 	return cmd
 }
+
+// exportGenesisDoc exports the state of the application stored in the given
+// database into the node's genesis document, which is returned as sorted and
+// indented JSON.
+func exportGenesisDoc(
+	ctx *Context, cdc codec.JSONMarshaler, appExporter AppExporter, db dbm.DB, traceWriter io.Writer,
+	height int64, forZeroHeight bool, jailWhiteList []string,
+) ([]byte, error) {
+	appState, validators, cp, err := appExporter(ctx.Logger, db, traceWriter, height, forZeroHeight, jailWhiteList)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting state: %v", err)
+	}
+
+	doc, err := tmtypes.GenesisDocFromFile(ctx.Config.GenesisFile())
+	if err != nil {
+		return nil, err
+	}
+
+	doc.AppState = appState
+	doc.Validators = validators
+	doc.ConsensusParams = &tmtypes.ConsensusParams{
+		Block: tmtypes.BlockParams{
+			MaxBytes:   cp.Block.MaxBytes,
+			MaxGas:     cp.Block.MaxGas,
+			TimeIotaMs: doc.ConsensusParams.Block.TimeIotaMs,
+		},
+		Evidence: tmtypes.EvidenceParams{
+			MaxAgeNumBlocks: cp.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  cp.Evidence.MaxAgeDuration,
+		},
+		Validator: tmtypes.ValidatorParams{
+			PubKeyTypes: cp.Validator.PubKeyTypes,
+		},
+	}
+
+	encoded, err := codec.MarshalJSONIndent(cdc, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.MustSortJSON(encoded), nil
+}
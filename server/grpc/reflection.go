@@ -0,0 +1,377 @@
+package grpc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"sync"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	dpb "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/golang/protobuf/proto" // nolint: staticcheck
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+// reflectionServer implements the gRPC server reflection service. Unlike the
+// implementation of the grpc/reflection package, which only knows the files and
+// types registered with golang/protobuf, it resolves those registered with
+// gogo/protobuf first, as the SDK's protobuf types are generated with it.
+type reflectionServer struct {
+	server *grpc.Server
+
+	initSymbols  sync.Once
+	serviceNames []string
+	symbols      map[string]*dpb.FileDescriptorProto // fully-qualified names to files
+}
+
+// RegisterReflectionService registers the gRPC server reflection service on the
+// given gRPC server.
+func RegisterReflectionService(server *grpc.Server) {
+	rpb.RegisterServerReflectionServer(server, &reflectionServer{server: server})
+}
+
+// descriptorMessage is implemented by generated protobuf messages.
+type descriptorMessage interface {
+	Descriptor() ([]byte, []int)
+}
+
+// ServerReflectionInfo implements the ServerReflectionServer interface.
+func (s *reflectionServer) ServerReflectionInfo(stream rpb.ServerReflection_ServerReflectionInfoServer) error {
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		out := &rpb.ServerReflectionResponse{
+			ValidHost:       in.Host,
+			OriginalRequest: in,
+		}
+
+		switch req := in.MessageRequest.(type) {
+		case *rpb.ServerReflectionRequest_FileByFilename:
+			enc, err := s.fileByFilename(req.FileByFilename)
+			setFileDescriptorResponse(out, enc, err)
+
+		case *rpb.ServerReflectionRequest_FileContainingSymbol:
+			enc, err := s.fileContainingSymbol(req.FileContainingSymbol)
+			setFileDescriptorResponse(out, enc, err)
+
+		case *rpb.ServerReflectionRequest_FileContainingExtension:
+			ext := req.FileContainingExtension
+			enc, err := s.fileContainingExtension(ext.ContainingType, ext.ExtensionNumber)
+			setFileDescriptorResponse(out, enc, err)
+
+		case *rpb.ServerReflectionRequest_AllExtensionNumbersOfType:
+			extNums, err := s.allExtensionNumbers(req.AllExtensionNumbersOfType)
+			if err != nil {
+				out.MessageResponse = errorResponse(err)
+				break
+			}
+
+			out.MessageResponse = &rpb.ServerReflectionResponse_AllExtensionNumbersResponse{
+				AllExtensionNumbersResponse: &rpb.ExtensionNumberResponse{
+					BaseTypeName:    req.AllExtensionNumbersOfType,
+					ExtensionNumber: extNums,
+				},
+			}
+
+		case *rpb.ServerReflectionRequest_ListServices:
+			svcNames, _ := s.getSymbols()
+			services := make([]*rpb.ServiceResponse, len(svcNames))
+			for i, name := range svcNames {
+				services[i] = &rpb.ServiceResponse{Name: name}
+			}
+
+			out.MessageResponse = &rpb.ServerReflectionResponse_ListServicesResponse{
+				ListServicesResponse: &rpb.ListServiceResponse{Service: services},
+			}
+
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid MessageRequest: %v", in.MessageRequest)
+		}
+
+		if err := stream.Send(out); err != nil {
+			return err
+		}
+	}
+}
+
+// getSymbols returns the names of the services of the gRPC server, and an index
+// of the fully-qualified names of all services, methods and types defined in
+// their files and the files they depend on.
+func (s *reflectionServer) getSymbols() ([]string, map[string]*dpb.FileDescriptorProto) {
+	s.initSymbols.Do(func() {
+		serviceInfo := s.server.GetServiceInfo()
+
+		s.symbols = make(map[string]*dpb.FileDescriptorProto)
+		s.serviceNames = make([]string, 0, len(serviceInfo))
+		processed := make(map[string]bool)
+
+		for svc, info := range serviceInfo {
+			s.serviceNames = append(s.serviceNames, svc)
+
+			filename, ok := info.Metadata.(string)
+			if !ok {
+				continue
+			}
+
+			fd, err := decodeFileDescriptor(fileDescriptor(filename))
+			if err != nil {
+				continue
+			}
+
+			s.processFile(fd, processed)
+		}
+
+		sort.Strings(s.serviceNames)
+	})
+
+	return s.serviceNames, s.symbols
+}
+
+// processFile indexes the symbols of the given file and of its dependencies.
+func (s *reflectionServer) processFile(fd *dpb.FileDescriptorProto, processed map[string]bool) {
+	if processed[fd.GetName()] {
+		return
+	}
+
+	processed[fd.GetName()] = true
+	prefix := fd.GetPackage()
+
+	for _, msg := range fd.MessageType {
+		s.processMessage(fd, prefix, msg)
+	}
+
+	for _, enum := range fd.EnumType {
+		s.symbols[fqn(prefix, enum.GetName())] = fd
+	}
+
+	for _, ext := range fd.Extension {
+		s.symbols[fqn(prefix, ext.GetName())] = fd
+	}
+
+	for _, svc := range fd.Service {
+		svcName := fqn(prefix, svc.GetName())
+		s.symbols[svcName] = fd
+
+		for _, method := range svc.Method {
+			s.symbols[fqn(svcName, method.GetName())] = fd
+		}
+	}
+
+	for _, dep := range fd.Dependency {
+		depFd, err := decodeFileDescriptor(fileDescriptor(dep))
+		if err != nil {
+			continue
+		}
+
+		s.processFile(depFd, processed)
+	}
+}
+
+func (s *reflectionServer) processMessage(fd *dpb.FileDescriptorProto, prefix string, msg *dpb.DescriptorProto) {
+	msgName := fqn(prefix, msg.GetName())
+	s.symbols[msgName] = fd
+
+	for _, nested := range msg.NestedType {
+		s.processMessage(fd, msgName, nested)
+	}
+
+	for _, enum := range msg.EnumType {
+		s.symbols[fqn(msgName, enum.GetName())] = fd
+	}
+
+	for _, ext := range msg.Extension {
+		s.symbols[fqn(msgName, ext.GetName())] = fd
+	}
+
+	for _, field := range msg.Field {
+		s.symbols[fqn(msgName, field.GetName())] = fd
+	}
+}
+
+// fileByFilename returns the encoded descriptor of the file with the given name.
+func (s *reflectionServer) fileByFilename(filename string) ([]byte, error) {
+	fd, err := decodeFileDescriptor(fileDescriptor(filename))
+	if err != nil {
+		return nil, fmt.Errorf("unknown file %s: %w", filename, err)
+	}
+
+	return gogoproto.Marshal(fd)
+}
+
+// fileContainingSymbol returns the encoded descriptor of the file which defines
+// the given service, method or type.
+func (s *reflectionServer) fileContainingSymbol(name string) ([]byte, error) {
+	_, symbols := s.getSymbols()
+
+	fd, ok := symbols[name]
+	if !ok {
+		// the type may not be part of the dependencies of any service
+		typ := messageType(name)
+		if typ == nil {
+			return nil, fmt.Errorf("unknown symbol: %s", name)
+		}
+
+		var err error
+		fd, err = fileDescriptorForType(typ)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return gogoproto.Marshal(fd)
+}
+
+// fileContainingExtension returns the encoded descriptor of the file which
+// defines the given extension of the given type.
+func (s *reflectionServer) fileContainingExtension(typeName string, extNum int32) ([]byte, error) {
+	exts, err := registeredExtensions(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	ext, ok := exts[extNum]
+	if !ok {
+		return nil, fmt.Errorf("unknown extension %d of type %s", extNum, typeName)
+	}
+
+	fd, err := decodeFileDescriptor(fileDescriptor(ext.Filename))
+	if err != nil {
+		return nil, err
+	}
+
+	return gogoproto.Marshal(fd)
+}
+
+// allExtensionNumbers returns the field numbers of all registered extensions of
+// the given type.
+func (s *reflectionServer) allExtensionNumbers(typeName string) ([]int32, error) {
+	exts, err := registeredExtensions(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	extNums := make([]int32, 0, len(exts))
+	for num := range exts {
+		extNums = append(extNums, num)
+	}
+
+	sort.Slice(extNums, func(i, j int) bool { return extNums[i] < extNums[j] })
+
+	return extNums, nil
+}
+
+// fileDescriptor returns the compressed descriptor of the file with the given
+// name registered with gogo/protobuf or, failing that, golang/protobuf.
+func fileDescriptor(filename string) []byte {
+	if enc := gogoproto.FileDescriptor(filename); enc != nil {
+		return enc
+	}
+
+	return proto.FileDescriptor(filename)
+}
+
+// messageType returns the type of the message with the given name registered
+// with gogo/protobuf or, failing that, golang/protobuf.
+func messageType(name string) reflect.Type {
+	if typ := gogoproto.MessageType(name); typ != nil {
+		return typ
+	}
+
+	return proto.MessageType(name)
+}
+
+// fileDescriptorForType returns the descriptor of the file defining the given
+// message type.
+func fileDescriptorForType(typ reflect.Type) (*dpb.FileDescriptorProto, error) {
+	msg, ok := reflect.Zero(typ).Interface().(descriptorMessage)
+	if !ok {
+		return nil, fmt.Errorf("failed to create message from type: %v", typ)
+	}
+
+	enc, _ := msg.Descriptor()
+
+	return decodeFileDescriptor(enc)
+}
+
+// registeredExtensions returns the extensions registered with gogo/protobuf for
+// the message type with the given name.
+func registeredExtensions(typeName string) (map[int32]*gogoproto.ExtensionDesc, error) {
+	typ := messageType(typeName)
+	if typ == nil {
+		return nil, fmt.Errorf("unknown type: %s", typeName)
+	}
+
+	msg, ok := reflect.Zero(typ).Interface().(gogoproto.Message)
+	if !ok {
+		return nil, fmt.Errorf("failed to create message from type: %v", typ)
+	}
+
+	return gogoproto.RegisteredExtensions(msg), nil
+}
+
+// decodeFileDescriptor decodes a compressed file descriptor.
+func decodeFileDescriptor(enc []byte) (*dpb.FileDescriptorProto, error) {
+	if enc == nil {
+		return nil, fmt.Errorf("file descriptor not found")
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(enc))
+	if err != nil {
+		return nil, fmt.Errorf("bad gzipped descriptor: %w", err)
+	}
+
+	raw, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("bad gzipped descriptor: %w", err)
+	}
+
+	fd := new(dpb.FileDescriptorProto)
+	if err := gogoproto.Unmarshal(raw, fd); err != nil {
+		return nil, fmt.Errorf("bad descriptor: %w", err)
+	}
+
+	return fd, nil
+}
+
+// setFileDescriptorResponse sets the response to a request of an encoded file
+// descriptor.
+func setFileDescriptorResponse(out *rpb.ServerReflectionResponse, enc []byte, err error) {
+	if err != nil {
+		out.MessageResponse = errorResponse(err)
+		return
+	}
+
+	out.MessageResponse = &rpb.ServerReflectionResponse_FileDescriptorResponse{
+		FileDescriptorResponse: &rpb.FileDescriptorResponse{FileDescriptorProto: [][]byte{enc}},
+	}
+}
+
+func errorResponse(err error) *rpb.ServerReflectionResponse_ErrorResponse {
+	return &rpb.ServerReflectionResponse_ErrorResponse{
+		ErrorResponse: &rpb.ErrorResponse{
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: err.Error(),
+		},
+	}
+}
+
+func fqn(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"fmt"
+	"net"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+)
+
+// Application defines an application which can register its gRPC query
+// services on a gRPC server.
+type Application interface {
+	RegisterGRPCServer(gogogrpc.Server)
+}
+
+// StartGRPCServer starts a gRPC server on the given address, serving the query
+// services of the application along with gRPC server reflection. The server
+// should be stopped by the caller.
+func StartGRPCServer(app Application, address string) (*grpc.Server, error) {
+	grpcSrv := grpc.NewServer()
+	app.RegisterGRPCServer(grpcSrv)
+	RegisterReflectionService(grpcSrv)
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	go func() {
+		// Serve only returns an error if the listener fails, after which the
+		// server is not serving anymore.
+		_ = grpcSrv.Serve(listener)
+	}()
+
+	return grpcSrv, nil
+}
@@ -0,0 +1,176 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	addr       sdk.AccAddress
+	server     *grpc.Server
+	conn       *grpc.ClientConn
+	balance    sdk.Coins
+	newBalance sdk.Coins
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.addr = sdk.AccAddress("addr1_______________")
+	s.balance = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.newBalance = sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+
+	app := simapp.SetupWithGenesisAccounts(
+		[]authtypes.GenesisAccount{authtypes.NewBaseAccount(s.addr, nil, 0, 0)},
+		banktypes.Balance{Address: s.addr, Coins: s.balance},
+	)
+
+	// change the balance at height 2
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 2})
+	s.Require().NoError(app.BankKeeper.SetBalances(ctx, s.addr, s.newBalance))
+	app.EndBlock(abci.RequestEndBlock{Height: 2})
+	app.Commit()
+
+	// find a free port for the server
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	address := listener.Addr().String()
+	s.Require().NoError(listener.Close())
+
+	s.server, err = servergrpc.StartGRPCServer(app, address)
+	s.Require().NoError(err)
+
+	s.conn, err = grpc.Dial(address, grpc.WithInsecure())
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.Require().NoError(s.conn.Close())
+	s.server.Stop()
+}
+
+func (s *IntegrationTestSuite) TestGRPCServer() {
+	bankClient := banktypes.NewQueryClient(s.conn)
+
+	res, err := bankClient.AllBalances(context.Background(), &banktypes.QueryAllBalancesRequest{Address: s.addr})
+	s.Require().NoError(err)
+	s.Require().Equal(s.newBalance, res.Balances)
+
+	// the keeper's errors are returned
+	_, err = bankClient.Balance(context.Background(), &banktypes.QueryBalanceRequest{Address: s.addr})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestGRPCServerReflection() {
+	stream, err := rpb.NewServerReflectionClient(s.conn).ServerReflectionInfo(context.Background())
+	s.Require().NoError(err)
+
+	// the bank query service is listed
+	s.Require().NoError(stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	}))
+	res, err := stream.Recv()
+	s.Require().NoError(err)
+
+	var services []string
+	for _, svc := range res.GetListServicesResponse().Service {
+		services = append(services, svc.Name)
+	}
+	s.Require().Contains(services, "cosmos.bank.Query")
+
+	// the descriptors of the gogoproto generated files are found
+	for _, symbol := range []string{"cosmos.bank.Query", "cosmos.bank.Query.AllBalances", "cosmos.bank.QueryAllBalancesRequest"} {
+		s.Require().NoError(stream.Send(&rpb.ServerReflectionRequest{
+			MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: symbol},
+		}))
+		res, err = stream.Recv()
+		s.Require().NoError(err)
+		s.Require().Len(res.GetFileDescriptorResponse().GetFileDescriptorProto(), 1, symbol)
+	}
+
+	s.Require().NoError(stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileByFilename{FileByFilename: "cosmos/bank/query.proto"},
+	}))
+	res, err = stream.Recv()
+	s.Require().NoError(err)
+	s.Require().Len(res.GetFileDescriptorResponse().GetFileDescriptorProto(), 1)
+
+	// unknown symbols return an error response
+	s.Require().NoError(stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "cosmos.bank.Unknown"},
+	}))
+	res, err = stream.Recv()
+	s.Require().NoError(err)
+	s.Require().NotNil(res.GetErrorResponse())
+}
+
+func (s *IntegrationTestSuite) TestGRPCServerHeight() {
+	bankClient := banktypes.NewQueryClient(s.conn)
+	req := &banktypes.QueryAllBalancesRequest{Address: s.addr}
+
+	testCases := []struct {
+		name       string
+		height     string
+		expHeight  string
+		expBalance sdk.Coins
+		expErr     bool
+	}{
+		{"latest height", "", "2", s.newBalance, false},
+		{"explicit latest height", "2", "2", s.newBalance, false},
+		{"historical height", "1", "1", s.balance, false},
+		{"future height", "3", "", nil, true},
+		{"negative height", "-1", "", nil, true},
+		{"invalid height", "foo", "", nil, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			ctx := context.Background()
+			if tc.height != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, tc.height)
+			}
+
+			var header metadata.MD
+			res, err := bankClient.AllBalances(ctx, req, grpc.Header(&header))
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Equal(codes.InvalidArgument, status.Code(err))
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expBalance, res.Balances)
+			s.Require().Equal([]string{tc.expHeight}, header.Get(grpctypes.GRPCBlockHeightHeader))
+		})
+	}
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func TestStartGRPCServerInvalidAddress(t *testing.T) {
+	_, err := servergrpc.StartGRPCServer(simapp.Setup(false), "invalid")
+	require.Error(t, err)
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// PruneCmd prunes the application state offline, deleting the versions of the
+// application stores which are not kept by the given pruning strategy, and
+// compacts the database.
+func PruneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune the application state offline and compact the database",
+		Long: `Prune the application state of a stopped node, deleting the historical versions of the
+application stores which the given pruning strategy does not keep, as if the node had run with it
+all along, and compact the database to reclaim the space they used. The latest version is always
+kept. The node must not be running.
+
+The pruning strategy is given with '--pruning' or, for the 'custom' strategy, with
+'--pruning-keep-recent' and '--pruning-keep-every':
+
+default: the last 100 states are kept in addition to every 100th state
+everything: all saved states will be deleted, keeping only the latest state
+custom: keep the last 'pruning-keep-recent' states in addition to every 'pruning-keep-every'th state
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			pruningOpts, err := getOfflinePruningOptions(cmd)
+			if err != nil {
+				return err
+			}
+
+			dbDir := filepath.Join(config.RootDir, "data", "application.db")
+			if _, err := os.Stat(dbDir); err != nil {
+				return fmt.Errorf("failed to open application database: %w", err)
+			}
+
+			sizeBefore, err := dirSize(dbDir)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+
+			heights, err := rootmulti.PruneVersions(db, pruningOpts)
+			if err != nil {
+				db.Close()
+				return err
+			}
+
+			if err := compactDB(db); err != nil {
+				db.Close()
+				return err
+			}
+
+			if err := db.Close(); err != nil {
+				return err
+			}
+
+			sizeAfter, err := dirSize(dbDir)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("pruned %d heights; reclaimed %d bytes (%d -> %d)\n",
+				len(heights), sizeBefore-sizeAfter, sizeBefore, sizeAfter)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, "", "The application home directory")
+	cmd.Flags().String(FlagPruning, types.PruningOptionDefault, "Pruning strategy (default|everything|custom)")
+	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
+
+	return cmd
+}
+
+// getOfflinePruningOptions returns the pruning options given by the command
+// flags. The pruning interval is irrelevant offline, so it is not validated.
+func getOfflinePruningOptions(cmd *cobra.Command) (types.PruningOptions, error) {
+	strategy, _ := cmd.Flags().GetString(FlagPruning)
+
+	var opts types.PruningOptions
+
+	switch strings.ToLower(strategy) {
+	case types.PruningOptionDefault, types.PruningOptionEverything:
+		opts = types.NewPruningOptionsFromString(strings.ToLower(strategy))
+
+	case types.PruningOptionCustom:
+		keepRecent, _ := cmd.Flags().GetUint64(FlagPruningKeepRecent)
+		keepEvery, _ := cmd.Flags().GetUint64(FlagPruningKeepEvery)
+		opts = types.NewPruningOptions(keepRecent, keepEvery, 0)
+
+	case types.PruningOptionNothing:
+		return opts, fmt.Errorf("pruning strategy %s does not prune any height", strategy)
+
+	default:
+		return opts, fmt.Errorf("unknown pruning strategy %s", strategy)
+	}
+
+	if opts.KeepEvery == 1 {
+		return opts, fmt.Errorf("pruning every %d heights keeps every height", opts.KeepEvery)
+	}
+
+	return opts, nil
+}
+
+// compactDB compacts the whole key range of the database, if its backend
+// supports it.
+func compactDB(db dbm.DB) error {
+	if levelDB, ok := db.(*dbm.GoLevelDB); ok {
+		return levelDB.DB().CompactRange(util.Range{})
+	}
+
+	return nil
+}
+
+// dirSize returns the total size of the files in the given directory.
+func dirSize(dir string) (int64, error) {
+	var size int64
+
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() {
+			size += info.Size()
+		}
+
+		return nil
+	})
+
+	return size, err
+}
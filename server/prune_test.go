@@ -0,0 +1,91 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestPruneCmd(t *testing.T) {
+	tempDir, clean := testutil.NewTestCaseDir(t)
+	defer clean()
+
+	db, err := openDB(tempDir)
+	require.NoError(t, err)
+
+	key := types.NewKVStoreKey("store")
+	ms := rootmulti.NewStore(db)
+	ms.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 0; i < 10; i++ {
+		store := ms.GetKVStore(key)
+		for j := 0; j < 100; j++ {
+			store.Set([]byte(fmt.Sprintf("key%d", j)), []byte(fmt.Sprintf("value%d-%d", i, j)))
+		}
+
+		ms.Commit()
+	}
+
+	require.NoError(t, db.Close())
+
+	serverCtx := NewDefaultContext()
+	ctx := context.WithValue(context.Background(), ServerContextKey, serverCtx)
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr bool
+		expOut string
+	}{
+		{"prune nothing", []string{"--pruning=nothing"}, true, ""},
+		{"unknown strategy", []string{"--pruning=foo"}, true, ""},
+		{"keep every height", []string{"--pruning=custom", "--pruning-keep-every=1"}, true, ""},
+		{"prune some", []string{"--pruning=custom", "--pruning-keep-recent=2", "--pruning-keep-every=3"}, false, "pruned 5 heights"},
+		{"prune everything", []string{"--pruning=everything"}, false, "pruned 4 heights"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := PruneCmd()
+
+			output := &bytes.Buffer{}
+			cmd.SetOut(output)
+			cmd.SetArgs(append([]string{fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir)}, tc.args...))
+
+			err := cmd.ExecuteContext(ctx)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Contains(t, output.String(), tc.expOut)
+		})
+	}
+
+	// only the latest version is left
+	db, err = openDB(tempDir)
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	ms = rootmulti.NewStore(db)
+	ms.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	_, err = ms.CacheMultiStoreWithVersion(9)
+	require.Error(t, err)
+	_, err = ms.CacheMultiStoreWithVersion(10)
+	require.NoError(t, err)
+}
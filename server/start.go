@@ -4,8 +4,12 @@ package server
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"runtime/pprof"
+	"sync"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -17,38 +21,55 @@ import (
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/rpc/client/local"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
 // Tendermint full-node start flags
 const (
-	flagWithTendermint     = "with-tendermint"
-	flagAddress            = "address"
-	flagTraceStore         = "trace-store"
-	flagCPUProfile         = "cpu-profile"
-	FlagMinGasPrices       = "minimum-gas-prices"
-	FlagHaltHeight         = "halt-height"
-	FlagHaltTime           = "halt-time"
-	FlagInterBlockCache    = "inter-block-cache"
-	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
-	FlagTrace              = "trace"
-	FlagInvCheckPeriod     = "inv-check-period"
+	flagWithTendermint       = "with-tendermint"
+	flagAddress              = "address"
+	flagTraceStore           = "trace-store"
+	flagCPUProfile           = "cpu-profile"
+	FlagMinGasPrices         = "minimum-gas-prices"
+	FlagHaltHeight           = "halt-height"
+	FlagHaltTime             = "halt-time"
+	FlagHaltExportPath       = "halt-export-path"
+	FlagInterBlockCache      = "inter-block-cache"
+	FlagInterBlockWriteCache = "inter-block-write-cache"
+	FlagUnsafeSkipUpgrades   = "unsafe-skip-upgrades"
+	FlagTrace                = "trace"
+	FlagInvCheckPeriod       = "inv-check-period"
+	FlagBlockGasReport       = "block-gas-report"
+	FlagQuerySnapshots       = "query-snapshots"
+	FlagStoreProfile         = "store-profile"
 
 	FlagPruning           = "pruning"
 	FlagPruningKeepRecent = "pruning-keep-recent"
 	FlagPruningKeepEvery  = "pruning-keep-every"
 	FlagPruningInterval   = "pruning-interval"
+
+	// gRPC-related flags
+	FlagGRPCEnable  = "grpc.enable"
+	FlagGRPCAddress = "grpc.address"
+
+	// state sync-related flags
+	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
-// Tendermint.
-func StartCmd(appCreator AppCreator) *cobra.Command {
+// Tendermint. The app exporter is used to export the state of the app once the
+// node halts, if configured.
+func StartCmd(appCreator AppCreator, appExporter AppExporter) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "start",
 		Short: "Run the full node",
@@ -68,8 +89,16 @@ custom: allow pruning options to be manually specified through 'pruning-keep-rec
 Node halting configurations exist in the form of two flags: '--halt-height' and '--halt-time'. During
 the ABCI Commit phase, the node will check if the current block height is greater than or equal to
 the halt-height or if the current block time is greater than or equal to the halt-time. If so, the
-node will attempt to gracefully shutdown and the block will not be committed. In addition, the node
-will not be able to commit subsequent blocks.
+block is committed and the node gracefully shuts down: Tendermint and the API and gRPC servers are
+stopped, the state of the application is exported as a genesis file to '--halt-export-path' if it is
+set, and the database is flushed and closed before the process exits.
+
+A gRPC server serving the application's query services against the latest committed state, along
+with gRPC server reflection, can be started with '--grpc.enable' on the '--grpc.address' address.
+
+State sync snapshots of the application state can be taken every '--state-sync.snapshot-interval'
+blocks and served to peers. The interval must be a multiple of 'pruning-keep-every', and
+'--state-sync.snapshot-keep-recent' controls how many of the most recent snapshots are retained.
 
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
@@ -81,8 +110,12 @@ which accepts a path for the resulting pprof file.
 			// options accordingly.
 			serverCtx.Viper.BindPFlags(cmd.Flags())
 
-			_, err := GetPruningOptionsFromFlags(serverCtx.Viper)
-			return err
+			if _, err := GetPruningOptionsFromFlags(serverCtx.Viper); err != nil {
+				return err
+			}
+
+			// fail before starting rather than once the node halts
+			return validateHaltExport(config.GetConfig(serverCtx.Viper).HaltExportPath, appExporter)
 		},
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			serverCtx := GetServerContextFromCmd(cmd)
@@ -91,12 +124,12 @@ which accepts a path for the resulting pprof file.
 			withTM, _ := cmd.Flags().GetBool(flagWithTendermint)
 			if !withTM {
 				serverCtx.Logger.Info("starting ABCI without Tendermint")
-				return startStandAlone(serverCtx, appCreator)
+				return startStandAlone(serverCtx, clientCtx.JSONMarshaler, appCreator, appExporter)
 			}
 
 			serverCtx.Logger.Info("starting ABCI with Tendermint")
 
-			err := startInProcess(serverCtx, clientCtx.JSONMarshaler, appCreator)
+			err := startInProcess(serverCtx, clientCtx.JSONMarshaler, appCreator, appExporter)
 			return err
 		},
 	}
@@ -109,7 +142,9 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().String(FlagHaltExportPath, "", "File path to export the application state to as a genesis file when the node halts")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Bool(FlagInterBlockWriteCache, false, "Hold the writes to the stores in the inter-block cache until they are committed")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
 	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
@@ -117,13 +152,22 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
+	cmd.Flags().Bool(FlagBlockGasReport, false, "Report the gas consumed in each block by message type and store key in EndBlock")
+	cmd.Flags().Uint(FlagQuerySnapshots, 1, "Number of recent committed heights kept in memory as read-only snapshots queries are served from (0 disables the snapshots)")
+	cmd.Flags().Bool(FlagStoreProfile, false, "Record the accesses made to the stores by key prefix, reported by the /app/store-profile query")
+
+	cmd.Flags().Bool(FlagGRPCEnable, false, "Define if the gRPC server should be enabled")
+	cmd.Flags().String(FlagGRPCAddress, config.DefaultGRPCAddress, "The gRPC server address to listen on")
+
+	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
 	return cmd
 }
 
-func startStandAlone(ctx *Context, appCreator AppCreator) error {
+func startStandAlone(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator, appExporter AppExporter) error {
 	addr := ctx.Viper.GetString(flagAddress)
 	home := ctx.Viper.GetString(flags.FlagHome)
 
@@ -152,17 +196,37 @@ func startStandAlone(ctx *Context, appCreator AppCreator) error {
 		tmos.Exit(err.Error())
 	}
 
-	TrapSignal(func() {
-		if err = svr.Stop(); err != nil {
-			tmos.Exit(err.Error())
+	var grpcSrv *grpc.Server
+
+	config := config.GetConfig(ctx.Viper)
+	if config.GRPC.Enable {
+		grpcSrv, err = servergrpc.StartGRPCServer(app, config.GRPC.Address)
+		if err != nil {
+			return err
 		}
-	})
+	}
+
+	var cleanupOnce sync.Once
 
-	// run forever (the node will not be returned)
-	select {}
+	cleanup := func() {
+		cleanupOnce.Do(func() {
+			if grpcSrv != nil {
+				grpcSrv.Stop()
+			}
+
+			if err = svr.Stop(); err != nil {
+				tmos.Exit(err.Error())
+			}
+		})
+	}
+
+	TrapSignal(cleanup)
+
+	// run until the app halts
+	return haltNode(ctx, cdc, app, appExporter, db, traceWriter, config.HaltExportPath, cleanup)
 }
 
-func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator) error {
+func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator, appExporter AppExporter) error {
 	cfg := ctx.Config
 	home := cfg.RootDir
 
@@ -237,6 +301,15 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator
 		}
 	}
 
+	var grpcSrv *grpc.Server
+
+	if config.GRPC.Enable {
+		grpcSrv, err = servergrpc.StartGRPCServer(app, config.GRPC.Address)
+		if err != nil {
+			return err
+		}
+	}
+
 	var cpuProfileCleanup func()
 
 	if cpuProfile := ctx.Viper.GetString(flagCPUProfile); cpuProfile != "" {
@@ -257,22 +330,97 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator
 		}
 	}
 
-	TrapSignal(func() {
-		if tmNode.IsRunning() {
-			_ = tmNode.Stop()
+	var cleanupOnce sync.Once
+
+	cleanup := func() {
+		cleanupOnce.Do(func() {
+			if tmNode.IsRunning() {
+				_ = tmNode.Stop()
+			}
+
+			if cpuProfileCleanup != nil {
+				cpuProfileCleanup()
+			}
+
+			if apiSrv != nil {
+				_ = apiSrv.Close()
+			}
+
+			if grpcSrv != nil {
+				grpcSrv.Stop()
+			}
+
+			ctx.Logger.Info("exiting...")
+		})
+	}
+
+	TrapSignal(cleanup)
+
+	// run until the app halts
+	return haltNode(ctx, cdc, app, appExporter, db, traceWriter, config.HaltExportPath, cleanup)
+}
+
+// validateHaltExport returns an error if the state of the app cannot be exported
+// to the given path once the node halts, i.e. if the path is set but there is no
+// app exporter, or the path is a directory or is not in a writable directory.
+func validateHaltExport(exportPath string, appExporter AppExporter) error {
+	if exportPath == "" {
+		return nil
+	}
+
+	if appExporter == nil {
+		return fmt.Errorf("cannot export state to %s: app exporter not defined", exportPath)
+	}
+
+	if info, err := os.Stat(exportPath); err == nil && info.IsDir() {
+		return fmt.Errorf("cannot export state to %s: path is a directory", exportPath)
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(exportPath), filepath.Base(exportPath))
+	if err != nil {
+		return fmt.Errorf("cannot export state to %s: %w", exportPath, err)
+	}
+
+	f.Close()
+
+	return os.Remove(f.Name())
+}
+
+// haltNode blocks until the app halts per its halt-height or halt-time. It then
+// stops the node with the given cleanup function, exports the state of the app
+// as a genesis file to the given path if it is not empty, and closes the
+// database, even if the export fails.
+func haltNode(
+	ctx *Context, cdc codec.JSONMarshaler, app Application, appExporter AppExporter,
+	db dbm.DB, traceWriter io.Writer, exportPath string, cleanup func(),
+) (err error) {
+	<-app.Halted()
+
+	ctx.Logger.Info("halting node per configuration")
+	cleanup()
+
+	defer func() {
+		if closeErr := db.Close(); err == nil {
+			err = closeErr
 		}
+	}()
 
-		if cpuProfileCleanup != nil {
-			cpuProfileCleanup()
+	if exportPath != "" {
+		if appExporter == nil {
+			return fmt.Errorf("cannot export state to %s: app exporter not defined", exportPath)
 		}
 
-		if apiSrv != nil {
-			_ = apiSrv.Close()
+		ctx.Logger.Info("exporting state", "path", exportPath)
+
+		genesis, err := exportGenesisDoc(ctx, cdc, appExporter, db, traceWriter, -1, false, nil)
+		if err != nil {
+			return err
 		}
 
-		ctx.Logger.Info("exiting...")
-	})
+		if err := ioutil.WriteFile(exportPath, genesis, 0644); err != nil {
+			return err
+		}
+	}
 
-	// run forever (the node will not be returned)
-	select {}
+	return nil
 }
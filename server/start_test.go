@@ -0,0 +1,139 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestHaltNode(t *testing.T) {
+	tempDir, clean := testutil.NewTestCaseDir(t)
+	defer clean()
+
+	require.NoError(t, createConfigFolder(tempDir))
+
+	db := dbm.NewMemDB()
+	app := simapp.NewSimApp(
+		log.NewNopLogger(), db, nil, true, map[int64]bool{}, tempDir, 0, baseapp.SetHaltHeight(2),
+	)
+
+	serverCtx := NewDefaultContext()
+	serverCtx.Config.RootDir = tempDir
+
+	genDoc := newDefaultGenesisDoc(app.Codec())
+	require.NoError(t, saveGenesisFile(genDoc, serverCtx.Config.GenesisFile()))
+
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   genDoc.AppState,
+	})
+
+	for height := int64(1); height <= 2; height++ {
+		select {
+		case <-app.Halted():
+			t.Fatalf("app halted before height %d", height)
+		default:
+		}
+
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	// no further blocks are processed once the app has halted, nor do they panic,
+	// and the node is stopped while the next block is held
+	begun := make(chan struct{})
+	go func() {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 3}})
+		close(begun)
+	}()
+
+	exporter := func(_ log.Logger, _ dbm.DB, _ io.Writer, height int64, forZeroHeight bool, _ []string) (json.RawMessage, []tmtypes.GenesisValidator, *abci.ConsensusParams, error) {
+		require.Equal(t, int64(-1), height)
+		require.False(t, forZeroHeight)
+
+		return app.ExportAppStateAndValidators(false, nil)
+	}
+
+	cleanedUp := false
+	exportPath := filepath.Join(tempDir, "export.json")
+
+	err := haltNode(serverCtx, app.Codec(), app, exporter, db, nil, exportPath, func() { cleanedUp = true })
+	require.NoError(t, err)
+	require.True(t, cleanedUp)
+
+	select {
+	case <-begun:
+		t.Fatal("app began a block after halting")
+	default:
+	}
+
+	exported, err := tmtypes.GenesisDocFromFile(exportPath)
+	require.NoError(t, err)
+	require.Equal(t, genDoc.ChainID, exported.ChainID)
+}
+
+func TestHaltNodeWithoutExporter(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, "", 0, baseapp.SetHaltHeight(1))
+
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   newDefaultGenesisDoc(app.Codec()).AppState,
+	})
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	// the database is closed even though the state cannot be exported
+	closing := &closeRecordingDB{DB: db}
+	err := haltNode(NewDefaultContext(), app.Codec(), app, nil, closing, nil, filepath.Join(os.TempDir(), "export.json"), func() {})
+	require.Error(t, err)
+	require.True(t, closing.closed)
+}
+
+// closeRecordingDB records whether it was closed.
+type closeRecordingDB struct {
+	dbm.DB
+	closed bool
+}
+
+func (db *closeRecordingDB) Close() error {
+	db.closed = true
+	return db.DB.Close()
+}
+
+func TestValidateHaltExport(t *testing.T) {
+	tempDir, clean := testutil.NewTestCaseDir(t)
+	defer clean()
+
+	exporter := func(log.Logger, dbm.DB, io.Writer, int64, bool, []string) (json.RawMessage, []tmtypes.GenesisValidator, *abci.ConsensusParams, error) {
+		return nil, nil, nil, nil
+	}
+
+	require.NoError(t, validateHaltExport("", nil))
+	require.NoError(t, validateHaltExport(filepath.Join(tempDir, "export.json"), exporter))
+	require.Error(t, validateHaltExport(filepath.Join(tempDir, "export.json"), nil))
+	require.Error(t, validateHaltExport(tempDir, exporter))
+	require.Error(t, validateHaltExport(filepath.Join(tempDir, "missing", "export.json"), exporter))
+
+	// the writability check leaves no file behind
+	files, err := ioutil.ReadDir(tempDir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
@@ -177,11 +177,12 @@ func AddCommands(rootCmd *cobra.Command, appCreator AppCreator, appExport AppExp
 	)
 
 	rootCmd.AddCommand(
-		StartCmd(appCreator),
+		StartCmd(appCreator, appExport),
 		UnsafeResetAllCmd(),
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(appExport),
+		PruneCmd(),
 		flags.LineBreak,
 		version.NewVersionCommand(),
 	)
@@ -9,6 +9,11 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -41,9 +46,71 @@ func TestBlockedAddrs(t *testing.T) {
 	db := dbm.NewMemDB()
 	app := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0)
 
+	ctx := app.BaseApp.NewContext(true, abci.Header{})
+
 	for acc := range maccPerms {
-		require.Equal(t, !allowedReceivingModAcc[acc], app.BankKeeper.BlockedAddr(app.AccountKeeper.GetModuleAddress(acc)))
+		require.Equal(t, !allowedReceivingModAcc[acc], app.BankKeeper.BlockedAddr(ctx, app.AccountKeeper.GetModuleAddress(acc)))
+	}
+}
+
+// ensure that the legacy total supply is migrated by the bank supply upgrade
+func TestBankSupplyUpgrade(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0)
+
+	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), NewDefaultGenesisState())
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+
+	// store the supply the way versions prior to the upgrade did
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	legacySupply := sdk.NewCoins(sdk.NewInt64Coin("bar", 50), sdk.NewInt64Coin("foo", 100))
+	bz, err := app.BankKeeper.MarshalSupply(banktypes.NewSupply(legacySupply))
+	require.NoError(t, err)
+	ctx.KVStore(app.GetKey(banktypes.StoreKey)).Set(banktypes.SupplyKey, bz)
+
+	// nor did they index the owners of each denomination
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr, legacySupply))
+
+	for _, storePrefix := range [][]byte{banktypes.DenomOwnersPrefix, banktypes.DenomOwnersCountPrefix} {
+		store := prefix.NewStore(ctx.KVStore(app.GetKey(banktypes.StoreKey)), storePrefix)
+
+		var keys [][]byte
+
+		iterator := store.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
 	}
+
+	_, broken := bankkeeper.DenomOwnersInvariant(app.BankKeeper)(ctx)
+	require.True(t, broken)
+
+	plan := upgradetypes.Plan{Name: BankSupplyUpgradeName, Height: 2}
+	require.NoError(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+	app.Commit()
+
+	header := abci.Header{Height: 2}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: 2})
+	app.Commit()
+
+	ctx = app.BaseApp.NewContext(true, header)
+	require.Equal(t, legacySupply, app.BankKeeper.GetSupply(ctx).GetTotal())
+	require.Equal(t, sdk.NewInt64Coin("foo", 100), app.BankKeeper.GetSupplyOf(ctx, "foo"))
+	require.False(t, ctx.KVStore(app.GetKey(banktypes.StoreKey)).Has(banktypes.SupplyKey))
+	require.Equal(t, int64(2), app.UpgradeKeeper.GetDoneHeight(ctx, BankSupplyUpgradeName))
+
+	msg, broken := bankkeeper.DenomOwnersInvariant(app.BankKeeper)(ctx)
+	require.False(t, broken, msg)
+	require.Equal(t, uint64(1), app.BankKeeper.GetDenomOwnersCount(ctx, "foo"))
 }
 
 func TestGetMaccPerms(t *testing.T) {
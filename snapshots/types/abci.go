@@ -0,0 +1,75 @@
+package types
+
+// The types below mirror the state sync messages of the ABCI protocol introduced
+// in Tendermint 0.34. The Tendermint version currently used by the SDK predates
+// state sync, so BaseApp serves snapshots through these types. Once Tendermint
+// is upgraded they map one-to-one onto their abci counterparts.
+
+// RequestListSnapshots lists the available snapshots.
+type RequestListSnapshots struct{}
+
+// ResponseListSnapshots contains the available snapshots.
+type ResponseListSnapshots struct {
+	Snapshots []*Snapshot
+}
+
+// RequestOfferSnapshot offers a snapshot to the application.
+type RequestOfferSnapshot struct {
+	Snapshot *Snapshot // snapshot offered by peers
+	AppHash  []byte    // light client-verified app hash for snapshot height
+}
+
+// OfferSnapshotResult is the result of a snapshot offer.
+type OfferSnapshotResult int32
+
+const (
+	OfferSnapshotUnknown      OfferSnapshotResult = iota // unknown result, abort all snapshot restoration
+	OfferSnapshotAccept                                  // snapshot accepted, apply chunks
+	OfferSnapshotAbort                                   // abort all snapshot restoration
+	OfferSnapshotReject                                  // reject this specific snapshot, try others
+	OfferSnapshotRejectFormat                            // reject all snapshots of this format, try others
+	OfferSnapshotRejectSender                            // reject all snapshots from the sender(s), try others
+)
+
+// ResponseOfferSnapshot contains the result of a snapshot offer.
+type ResponseOfferSnapshot struct {
+	Result OfferSnapshotResult
+}
+
+// RequestLoadSnapshotChunk loads a snapshot chunk.
+type RequestLoadSnapshotChunk struct {
+	Height uint64
+	Format uint32
+	Chunk  uint32
+}
+
+// ResponseLoadSnapshotChunk contains a snapshot chunk.
+type ResponseLoadSnapshotChunk struct {
+	Chunk []byte
+}
+
+// RequestApplySnapshotChunk applies a snapshot chunk.
+type RequestApplySnapshotChunk struct {
+	Index  uint32
+	Chunk  []byte
+	Sender string
+}
+
+// ApplySnapshotChunkResult is the result of applying a snapshot chunk.
+type ApplySnapshotChunkResult int32
+
+const (
+	ApplySnapshotChunkUnknown        ApplySnapshotChunkResult = iota // unknown result, abort all snapshot restoration
+	ApplySnapshotChunkAccept                                         // chunk successfully accepted
+	ApplySnapshotChunkAbort                                          // abort all snapshot restoration
+	ApplySnapshotChunkRetry                                          // retry chunk (combine with refetch and reject)
+	ApplySnapshotChunkRetrySnapshot                                  // retry snapshot (combine with refetch and reject)
+	ApplySnapshotChunkRejectSnapshot                                 // reject this snapshot, try others
+)
+
+// ResponseApplySnapshotChunk contains the result of applying a snapshot chunk.
+type ResponseApplySnapshotChunk struct {
+	Result        ApplySnapshotChunkResult
+	RefetchChunks []uint32 // chunks to refetch and reapply
+	RejectSenders []string // chunk senders to reject and ban
+}
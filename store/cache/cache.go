@@ -2,9 +2,14 @@ package cache
 
 import (
 	"fmt"
+	"io"
+
+	"github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 
 	lru "github.com/hashicorp/golang-lru"
 )
@@ -23,11 +28,37 @@ type (
 	// CommitKVStore. Reads first hit the internal ARC (Adaptive Replacement Cache).
 	// During a cache miss, the read is delegated to the underlying CommitKVStore
 	// and cached. Deletes and writes always happen to both the cache and the
-	// CommitKVStore in a write-through manner. Caching performed in the
-	// CommitKVStore and below is completely irrelevant to this layer.
+	// CommitKVStore in a write-through manner, unless the cache is write-back.
+	// Caching performed in the CommitKVStore and below is completely irrelevant
+	// to this layer.
+	//
+	// A write-back cache instead holds deletes and writes as dirty entries, which
+	// are read from until they are flushed to the CommitKVStore on Commit, or
+	// dropped by Discard. They are flushed in the order they were made, so that
+	// the resulting IAVL tree, and thus the app hash, is the same as with a
+	// write-through cache.
 	CommitKVStoreCache struct {
 		types.CommitKVStore
 		cache *lru.ARCCache
+
+		writeBack bool
+		dirty     map[string]cValue
+		journal   []cEntry
+
+		// labels are the telemetry labels of the store the cache belongs to, if
+		// known.
+		labels []metrics.Label
+	}
+
+	// cValue is the value of a dirty entry; a nil value is a deletion.
+	cValue struct {
+		value []byte
+	}
+
+	// cEntry is a delete or write held by a write-back cache.
+	cEntry struct {
+		key   string
+		value []byte
 	}
 
 	// CommitKVStoreCacheManager maintains a mapping from a StoreKey to a
@@ -36,6 +67,7 @@ type (
 	// CommitMultiStore.
 	CommitKVStoreCacheManager struct {
 		cacheSize uint
+		writeBack bool
 		caches    map[string]types.CommitKVStore
 	}
 )
@@ -52,6 +84,16 @@ func NewCommitKVStoreCache(store types.CommitKVStore, size uint) *CommitKVStoreC
 	}
 }
 
+// NewWriteBackCommitKVStoreCache returns a CommitKVStoreCache which holds the
+// deletes and writes made to it until Commit.
+func NewWriteBackCommitKVStoreCache(store types.CommitKVStore, size uint) *CommitKVStoreCache {
+	ckv := NewCommitKVStoreCache(store, size)
+	ckv.writeBack = true
+	ckv.dirty = make(map[string]cValue)
+
+	return ckv
+}
+
 func NewCommitKVStoreCacheManager(size uint) *CommitKVStoreCacheManager {
 	return &CommitKVStoreCacheManager{
 		cacheSize: size,
@@ -59,12 +101,29 @@ func NewCommitKVStoreCacheManager(size uint) *CommitKVStoreCacheManager {
 	}
 }
 
+// NewWriteBackCommitKVStoreCacheManager returns a CommitKVStoreCacheManager
+// whose caches are write-back.
+func NewWriteBackCommitKVStoreCacheManager(size uint) *CommitKVStoreCacheManager {
+	cmgr := NewCommitKVStoreCacheManager(size)
+	cmgr.writeBack = true
+
+	return cmgr
+}
+
 // GetStoreCache returns a Cache from the CommitStoreCacheManager for a given
 // StoreKey. If no Cache exists for the StoreKey, then one is created and set.
 // The returned Cache is meant to be used in a persistent manner.
 func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store types.CommitKVStore) types.CommitKVStore {
 	if cmgr.caches[key.Name()] == nil {
-		cmgr.caches[key.Name()] = NewCommitKVStoreCache(store, cmgr.cacheSize)
+		var ckv *CommitKVStoreCache
+		if cmgr.writeBack {
+			ckv = NewWriteBackCommitKVStoreCache(store, cmgr.cacheSize)
+		} else {
+			ckv = NewCommitKVStoreCache(store, cmgr.cacheSize)
+		}
+
+		ckv.labels = []metrics.Label{telemetry.NewLabel("store_key", key.Name())}
+		cmgr.caches[key.Name()] = ckv
 	}
 
 	return cmgr.caches[key.Name()]
@@ -79,8 +138,13 @@ func (cmgr *CommitKVStoreCacheManager) Unwrap(key types.StoreKey) types.CommitKV
 	return nil
 }
 
-// Reset resets in the internal caches.
+// Reset resets in the internal caches, discarding the writes held by
+// write-back caches.
 func (cmgr *CommitKVStoreCacheManager) Reset() {
+	for _, ckv := range cmgr.caches {
+		ckv.(*CommitKVStoreCache).Discard()
+	}
+
 	// Clear the map.
 	// Please note that we are purposefully using the map clearing idiom.
 	// See https://github.com/cosmos/cosmos-sdk/issues/6681.
@@ -94,39 +158,172 @@ func (ckv *CommitKVStoreCache) CacheWrap() types.CacheWrap {
 	return cachekv.NewStore(ckv)
 }
 
-// Get retrieves a value by key. It will first look in the write-through cache.
-// If the value doesn't exist in the write-through cache, the query is delegated
-// to the underlying CommitKVStore.
+// CacheWrapWithTrace returns the inter-block cache as a cache-wrapped
+// CommitKVStore with tracing enabled.
+func (ckv *CommitKVStoreCache) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(ckv, w, tc))
+}
+
+// Get retrieves a value by key. It will first look in the dirty entries of a
+// write-back cache, then in the cache. If the value doesn't exist in the cache,
+// the query is delegated to the underlying CommitKVStore.
 func (ckv *CommitKVStoreCache) Get(key []byte) []byte {
 	types.AssertValidKey(key)
 
 	keyStr := string(key)
+
+	if dirty, ok := ckv.dirty[keyStr]; ok {
+		ckv.incrCounter("hit")
+		return dirty.value
+	}
+
 	valueI, ok := ckv.cache.Get(keyStr)
 	if ok {
 		// cache hit
+		ckv.incrCounter("hit")
 		return valueI.([]byte)
 	}
 
 	// cache miss; write to cache
+	ckv.incrCounter("miss")
+
 	value := ckv.CommitKVStore.Get(key)
 	ckv.cache.Add(keyStr, value)
 
 	return value
 }
 
-// Set inserts a key/value pair into both the write-through cache and the
-// underlying CommitKVStore.
+// Has returns true if the key exists, taking the dirty entries of a write-back
+// cache into account.
+func (ckv *CommitKVStoreCache) Has(key []byte) bool {
+	if !ckv.writeBack {
+		return ckv.CommitKVStore.Has(key)
+	}
+
+	return ckv.Get(key) != nil
+}
+
+// Set inserts a key/value pair into the cache and into the underlying
+// CommitKVStore, or into the dirty entries of a write-back cache.
 func (ckv *CommitKVStoreCache) Set(key, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
 
+	if ckv.writeBack {
+		ckv.setDirty(string(key), value)
+		return
+	}
+
 	ckv.cache.Add(string(key), value)
 	ckv.CommitKVStore.Set(key, value)
 }
 
-// Delete removes a key/value pair from both the write-through cache and the
-// underlying CommitKVStore.
+// Delete removes a key/value pair from both the cache and the underlying
+// CommitKVStore, or records its deletion in the dirty entries of a write-back
+// cache.
 func (ckv *CommitKVStoreCache) Delete(key []byte) {
+	if ckv.writeBack {
+		ckv.setDirty(string(key), nil)
+		return
+	}
+
 	ckv.cache.Remove(string(key))
 	ckv.CommitKVStore.Delete(key)
 }
+
+// Iterator implements types.KVStore. The iterator of a write-back cache
+// includes its dirty entries.
+func (ckv *CommitKVStoreCache) Iterator(start, end []byte) types.Iterator {
+	if len(ckv.dirty) == 0 {
+		return ckv.CommitKVStore.Iterator(start, end)
+	}
+
+	return ckv.dirtyView().Iterator(start, end)
+}
+
+// ReverseIterator implements types.KVStore. The iterator of a write-back cache
+// includes its dirty entries.
+func (ckv *CommitKVStoreCache) ReverseIterator(start, end []byte) types.Iterator {
+	if len(ckv.dirty) == 0 {
+		return ckv.CommitKVStore.ReverseIterator(start, end)
+	}
+
+	return ckv.dirtyView().ReverseIterator(start, end)
+}
+
+// Commit flushes the dirty entries of a write-back cache to the underlying
+// CommitKVStore, and commits it.
+func (ckv *CommitKVStoreCache) Commit() types.CommitID {
+	ckv.flush()
+	return ckv.CommitKVStore.Commit()
+}
+
+// Discard drops the dirty entries of a write-back cache without writing them to
+// the underlying CommitKVStore, e.g. when the block they were made in is not
+// committed.
+func (ckv *CommitKVStoreCache) Discard() {
+	if len(ckv.journal) == 0 {
+		return
+	}
+
+	telemetry.IncrCounterWithLabels([]string{"store", "inter_block_cache", "discarded"}, float32(len(ckv.journal)), ckv.labels)
+
+	ckv.dirty = make(map[string]cValue)
+	ckv.journal = nil
+}
+
+// dirtyView returns a view of the underlying CommitKVStore with the dirty
+// entries of a write-back cache applied, which is never written.
+func (ckv *CommitKVStoreCache) dirtyView() types.KVStore {
+	view := cachekv.NewStore(ckv.CommitKVStore)
+
+	for key, dirty := range ckv.dirty {
+		if dirty.value == nil {
+			view.Delete([]byte(key))
+		} else {
+			view.Set([]byte(key), dirty.value)
+		}
+	}
+
+	return view
+}
+
+func (ckv *CommitKVStoreCache) setDirty(key string, value []byte) {
+	ckv.dirty[key] = cValue{value: value}
+	ckv.journal = append(ckv.journal, cEntry{key: key, value: value})
+}
+
+// flush writes the dirty entries of a write-back cache to the underlying
+// CommitKVStore, in the order they were made, and moves them to the cache.
+func (ckv *CommitKVStoreCache) flush() {
+	if len(ckv.journal) == 0 {
+		return
+	}
+
+	defer telemetry.MeasureSince("store", "inter_block_cache", "flush")
+
+	for _, entry := range ckv.journal {
+		if entry.value == nil {
+			ckv.CommitKVStore.Delete([]byte(entry.key))
+		} else {
+			ckv.CommitKVStore.Set([]byte(entry.key), entry.value)
+		}
+	}
+
+	telemetry.IncrCounterWithLabels([]string{"store", "inter_block_cache", "flushed"}, float32(len(ckv.journal)), ckv.labels)
+
+	for key, dirty := range ckv.dirty {
+		if dirty.value == nil {
+			ckv.cache.Remove(key)
+		} else {
+			ckv.cache.Add(key, dirty.value)
+		}
+	}
+
+	ckv.dirty = make(map[string]cValue)
+	ckv.journal = nil
+}
+
+func (ckv *CommitKVStoreCache) incrCounter(name string) {
+	telemetry.IncrCounterWithLabels([]string{"store", "inter_block_cache", name}, 1, ckv.labels)
+}
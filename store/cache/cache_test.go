@@ -67,3 +67,148 @@ func TestStoreCache(t *testing.T) {
 		require.Nil(t, store.Get(key))
 	}
 }
+
+func TestWriteBackStoreCache(t *testing.T) {
+	newStore := func() *iavlstore.Store {
+		tree, err := iavl.NewMutableTree(dbm.NewMemDB(), 100)
+		require.NoError(t, err)
+		return iavlstore.UnsafeNewStore(tree)
+	}
+
+	sKey := types.NewKVStoreKey("test")
+
+	store := newStore()
+	kvStore := cache.NewWriteBackCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize).GetStoreCache(sKey, store)
+
+	refStore := newStore()
+	refKVStore := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize).GetStoreCache(sKey, refStore)
+
+	for block := 0; block < 3; block++ {
+		for i := 0; i < 50; i++ {
+			// write keys out of order, rewriting and deleting some of them
+			key := []byte(fmt.Sprintf("key_%d", (i*7)%20))
+			value := []byte(fmt.Sprintf("value_%d_%d", block, i))
+
+			for _, s := range []types.CommitKVStore{kvStore, refKVStore} {
+				if i%5 == 4 {
+					s.Delete(key)
+				} else {
+					s.Set(key, value)
+				}
+			}
+
+			require.Equal(t, refKVStore.Get(key), kvStore.Get(key))
+			require.Equal(t, refKVStore.Has(key), kvStore.Has(key))
+		}
+
+		// the writes are held until commit
+		require.Equal(t, block == 0, store.Get([]byte("key_0")) == nil)
+
+		require.Equal(t, refKVStore.Commit(), kvStore.Commit())
+		require.Equal(t, refStore.Get([]byte("key_0")), store.Get([]byte("key_0")))
+	}
+
+	// iterating includes the dirty entries, without writing them
+	kvStore.Set([]byte("key_new"), []byte("value"))
+	kvStore.Delete([]byte("key_1"))
+
+	iter := kvStore.Iterator([]byte("key_1"), nil)
+	require.True(t, iter.Valid())
+	require.NotEqual(t, []byte("key_1"), iter.Key())
+	iter.Close()
+
+	iter = kvStore.ReverseIterator(nil, nil)
+	require.True(t, iter.Valid())
+	require.Equal(t, []byte("key_new"), iter.Key())
+	require.Equal(t, []byte("value"), iter.Value())
+	iter.Close()
+
+	require.Nil(t, store.Get([]byte("key_new")))
+	require.NotNil(t, store.Get([]byte("key_1")))
+}
+
+// writeRecordingStore records the deletes and writes made to a CommitKVStore,
+// a nil value being a deletion.
+type writeRecordingStore struct {
+	types.CommitKVStore
+	writes []types.KVPair
+}
+
+func (s *writeRecordingStore) Set(key, value []byte) {
+	s.writes = append(s.writes, types.KVPair{Key: key, Value: value})
+	s.CommitKVStore.Set(key, value)
+}
+
+func (s *writeRecordingStore) Delete(key []byte) {
+	s.writes = append(s.writes, types.KVPair{Key: key})
+	s.CommitKVStore.Delete(key)
+}
+
+func TestWriteBackStoreCacheFlushOrder(t *testing.T) {
+	tree, err := iavl.NewMutableTree(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+
+	store := &writeRecordingStore{CommitKVStore: iavlstore.UnsafeNewStore(tree)}
+	kvStore := cache.NewWriteBackCommitKVStoreCache(store, cache.DefaultCommitKVStoreCacheSize)
+
+	kvStore.Set([]byte("b"), []byte("1"))
+	kvStore.Set([]byte("a"), []byte("2"))
+	kvStore.Delete([]byte("b"))
+	kvStore.Set([]byte("c"), []byte("3"))
+	kvStore.Set([]byte("b"), []byte("4"))
+	require.Empty(t, store.writes)
+
+	// the held entries are flushed on Commit in the order they were made
+	kvStore.Commit()
+	require.Equal(t, []types.KVPair{
+		{Key: []byte("b"), Value: []byte("1")},
+		{Key: []byte("a"), Value: []byte("2")},
+		{Key: []byte("b")},
+		{Key: []byte("c"), Value: []byte("3")},
+		{Key: []byte("b"), Value: []byte("4")},
+	}, store.writes)
+
+	// nothing is left to flush
+	store.writes = nil
+	kvStore.Commit()
+	require.Empty(t, store.writes)
+	require.Equal(t, []byte("4"), kvStore.Get([]byte("b")))
+}
+
+func TestWriteBackStoreCacheDiscard(t *testing.T) {
+	newStore := func() *iavlstore.Store {
+		tree, err := iavl.NewMutableTree(dbm.NewMemDB(), 100)
+		require.NoError(t, err)
+		return iavlstore.UnsafeNewStore(tree)
+	}
+
+	sKey := types.NewKVStoreKey("test")
+	mngr := cache.NewWriteBackCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
+
+	store, refStore := newStore(), newStore()
+	kvStore := mngr.GetStoreCache(sKey, store)
+
+	kvStore.Set([]byte("a"), []byte("1"))
+	refStore.Set([]byte("a"), []byte("1"))
+	require.Equal(t, refStore.Commit(), kvStore.Commit())
+
+	// writes held when the block fails are discarded by a reset, and never
+	// reach the underlying store
+	kvStore.Set([]byte("a"), []byte("2"))
+	kvStore.Set([]byte("b"), []byte("3"))
+	mngr.Reset()
+
+	kvStore = mngr.GetStoreCache(sKey, store)
+	require.Equal(t, []byte("1"), kvStore.Get([]byte("a")))
+	require.Nil(t, kvStore.Get([]byte("b")))
+	require.Equal(t, refStore.Commit(), kvStore.Commit())
+	require.Nil(t, store.Get([]byte("b")))
+
+	// a discarded cache keeps serving the committed values
+	ckv := cache.NewWriteBackCommitKVStoreCache(store, cache.DefaultCommitKVStoreCacheSize)
+	ckv.Delete([]byte("a"))
+	require.Nil(t, ckv.Get([]byte("a")))
+	ckv.Discard()
+	require.Equal(t, []byte("1"), ckv.Get([]byte("a")))
+	require.Equal(t, refStore.Commit(), ckv.Commit())
+}
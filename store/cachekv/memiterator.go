@@ -1,46 +1,111 @@
 package cachekv
 
 import (
-	"container/list"
+	"bytes"
 	"errors"
 
-	tmkv "github.com/tendermint/tendermint/libs/kv"
-	dbm "github.com/tendermint/tm-db"
+	"github.com/google/btree"
 )
 
-// Iterates over iterKVCache items.
-// if key is nil, means it was deleted.
+// The number of items a memIterator reads from its B-tree at once starts at
+// minMemIteratorChunkSize, so that iterating over a few items is cheap, and is
+// doubled with each chunk up to maxMemIteratorChunkSize.
+const (
+	minMemIteratorChunkSize = 16
+	maxMemIteratorChunkSize = 4096
+)
+
+// Iterates over a snapshot of the dirty items of a cache.
+// if value is nil, means it was deleted.
 // Implements Iterator.
+//
+// Items are read from the B-tree in chunks, each starting from the key of the
+// last item read, so that iterating over a range takes time logarithmic in the
+// size of the tree and linear in the size of the range.
 type memIterator struct {
 	start, end []byte
-	items      []*tmkv.Pair
+	items      *btree.BTree
 	ascending  bool
+
+	chunk     []*dirtyItem
+	chunkSize int
+	last      []byte
+	done      bool
 }
 
-func newMemIterator(start, end []byte, items *list.List, ascending bool) *memIterator {
-	itemsInDomain := make([]*tmkv.Pair, 0)
+func newMemIterator(start, end []byte, items *btree.BTree, ascending bool) *memIterator {
+	mi := &memIterator{
+		start:     start,
+		end:       end,
+		items:     items,
+		ascending: ascending,
+		chunkSize: minMemIteratorChunkSize,
+	}
 
-	var entered bool
+	mi.readChunk()
+
+	return mi
+}
+
+// readChunk reads the next chunk of items if the current one was consumed.
+func (mi *memIterator) readChunk() {
+	if len(mi.chunk) > 0 || mi.done {
+		return
+	}
+
+	mi.chunk = mi.chunk[:0]
+	full := false
+
+	visit := func(i btree.Item) bool {
+		item := i.(*dirtyItem)
+
+		// the iteration resumes from the last item read, which is skipped
+		if mi.last != nil && bytes.Equal(item.key, mi.last) {
+			return true
+		}
+
+		if mi.ascending && mi.end != nil && bytes.Compare(item.key, mi.end) >= 0 {
+			return false
+		}
 
-	for e := items.Front(); e != nil; e = e.Next() {
-		item := e.Value.(*tmkv.Pair)
-		if !dbm.IsKeyInDomain(item.Key, start, end) {
-			if entered {
-				break
-			}
+		if !mi.ascending && mi.start != nil && bytes.Compare(item.key, mi.start) < 0 {
+			return false
+		}
 
-			continue
+		// the end of a descending iteration is exclusive
+		if !mi.ascending && mi.last == nil && mi.end != nil && bytes.Equal(item.key, mi.end) {
+			return true
 		}
 
-		itemsInDomain = append(itemsInDomain, item)
-		entered = true
+		mi.chunk = append(mi.chunk, item)
+		full = len(mi.chunk) == mi.chunkSize
+
+		return !full
 	}
 
-	return &memIterator{
-		start:     start,
-		end:       end,
-		items:     itemsInDomain,
-		ascending: ascending,
+	switch {
+	case mi.ascending && mi.last != nil:
+		mi.items.AscendGreaterOrEqual(&dirtyItem{key: mi.last}, visit)
+	case mi.ascending && mi.start != nil:
+		mi.items.AscendGreaterOrEqual(&dirtyItem{key: mi.start}, visit)
+	case mi.ascending:
+		mi.items.Ascend(visit)
+	case mi.last != nil:
+		mi.items.DescendLessOrEqual(&dirtyItem{key: mi.last}, visit)
+	case mi.end != nil:
+		mi.items.DescendLessOrEqual(&dirtyItem{key: mi.end}, visit)
+	default:
+		mi.items.Descend(visit)
+	}
+
+	if len(mi.chunk) > 0 {
+		mi.last = mi.chunk[len(mi.chunk)-1].key
+	}
+
+	mi.done = !full
+
+	if mi.chunkSize < maxMemIteratorChunkSize {
+		mi.chunkSize *= 2
 	}
 }
 
@@ -49,7 +114,7 @@ func (mi *memIterator) Domain() ([]byte, []byte) {
 }
 
 func (mi *memIterator) Valid() bool {
-	return len(mi.items) > 0
+	return len(mi.chunk) > 0
 }
 
 func (mi *memIterator) assertValid() {
@@ -61,37 +126,25 @@ func (mi *memIterator) assertValid() {
 func (mi *memIterator) Next() {
 	mi.assertValid()
 
-	if mi.ascending {
-		mi.items = mi.items[1:]
-	} else {
-		mi.items = mi.items[:len(mi.items)-1]
-	}
+	mi.chunk = mi.chunk[1:]
+	mi.readChunk()
 }
 
 func (mi *memIterator) Key() []byte {
 	mi.assertValid()
-
-	if mi.ascending {
-		return mi.items[0].Key
-	}
-
-	return mi.items[len(mi.items)-1].Key
+	return mi.chunk[0].key
 }
 
 func (mi *memIterator) Value() []byte {
 	mi.assertValid()
-
-	if mi.ascending {
-		return mi.items[0].Value
-	}
-
-	return mi.items[len(mi.items)-1].Value
+	return mi.chunk[0].value
 }
 
 func (mi *memIterator) Close() {
 	mi.start = nil
 	mi.end = nil
 	mi.items = nil
+	mi.chunk = nil
 }
 
 // Error returns an error if the memIterator is invalid defined by the Valid
@@ -2,13 +2,12 @@ package cachekv
 
 import (
 	"bytes"
-	"container/list"
 	"io"
-	"sort"
+	"strconv"
 	"sync"
 
-	tmkv "github.com/tendermint/tendermint/libs/kv"
-	dbm "github.com/tendermint/tm-db"
+	"github.com/armon/go-metrics"
+	"github.com/google/btree"
 
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -23,23 +22,51 @@ type cValue struct {
 	dirty   bool
 }
 
+// dirtyItem is an entry of the sorted dirty cache, with a nil value if the
+// key was deleted. Items are never modified once inserted, as they may be
+// shared with the snapshots of iterators.
+type dirtyItem struct {
+	key   []byte
+	value []byte
+}
+
+// Less implements btree.Item.
+func (item *dirtyItem) Less(than btree.Item) bool {
+	return bytes.Compare(item.key, than.(*dirtyItem).key) < 0
+}
+
+// dirtyCacheDegree is the degree of the B-tree of the sorted dirty cache.
+const dirtyCacheDegree = 32
+
 // Store wraps an in-memory cache around an underlying types.KVStore.
+//
+// The entries written to the cache are kept sorted in a copy-on-write B-tree,
+// so that iterators can take a snapshot of them in constant time and iterate
+// over a range in time logarithmic in the number of dirty entries, and Write
+// can write them to the parent in order without sorting them.
 type Store struct {
-	mtx           sync.Mutex
-	cache         map[string]*cValue
-	unsortedCache map[string]struct{}
-	sortedCache   *list.List // always ascending sorted
-	parent        types.KVStore
+	mtx    sync.Mutex
+	cache  map[string]*cValue
+	dirty  *btree.BTree // always ascending sorted
+	parent types.KVStore
+
+	// depth is the number of nested cache-wrapped stores, this one included
+	depth int
 }
 
 var _ types.CacheKVStore = (*Store)(nil)
 
 func NewStore(parent types.KVStore) *Store {
+	depth := 1
+	if p, ok := parent.(*Store); ok {
+		depth = p.depth + 1
+	}
+
 	return &Store{
-		cache:         make(map[string]*cValue),
-		unsortedCache: make(map[string]struct{}),
-		sortedCache:   list.New(),
-		parent:        parent,
+		cache:  make(map[string]*cValue),
+		dirty:  btree.New(dirtyCacheDegree),
+		parent: parent,
+		depth:  depth,
 	}
 }
 
@@ -101,37 +128,29 @@ func (store *Store) Write() {
 	defer store.mtx.Unlock()
 	defer telemetry.MeasureSince("store", "cachekv", "write")
 
-	// We need a copy of all of the keys.
-	// Not the best, but probably not a bottleneck depending.
-	keys := make([]string, 0, len(store.cache))
-
-	for key, dbValue := range store.cache {
-		if dbValue.dirty {
-			keys = append(keys, key)
-		}
-	}
-
-	sort.Strings(keys)
+	telemetry.IncrCounterWithLabels(
+		[]string{"store", "cachekv", "write", "entries"},
+		float32(store.dirty.Len()),
+		[]metrics.Label{telemetry.NewLabel("depth", strconv.Itoa(store.depth))},
+	)
 
 	// TODO: Consider allowing usage of Batch, which would allow the write to
 	// at least happen atomically.
-	for _, key := range keys {
-		cacheValue := store.cache[key]
-
-		switch {
-		case cacheValue.deleted:
-			store.parent.Delete([]byte(key))
-		case cacheValue.value == nil:
-			// Skip, it already doesn't exist in parent.
-		default:
-			store.parent.Set([]byte(key), cacheValue.value)
+	store.dirty.Ascend(func(i btree.Item) bool {
+		item := i.(*dirtyItem)
+
+		if item.value == nil {
+			store.parent.Delete(item.key)
+		} else {
+			store.parent.Set(item.key, item.value)
 		}
-	}
 
-	// Clear the cache
+		return true
+	})
+
+	// Clear the cache; iterators keep their own snapshot of the dirty items.
 	store.cache = make(map[string]*cValue)
-	store.unsortedCache = make(map[string]struct{})
-	store.sortedCache = list.New()
+	store.dirty = btree.New(dirtyCacheDegree)
 }
 
 //----------------------------------------
@@ -172,65 +191,23 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 		parent = store.parent.ReverseIterator(start, end)
 	}
 
-	store.dirtyItems(start, end)
-	cache = newMemIterator(start, end, store.sortedCache, ascending)
+	cache = newMemIterator(start, end, store.dirty.Clone(), ascending)
 
 	return newCacheMergeIterator(parent, cache, ascending)
 }
 
-// Constructs a slice of dirty items, to use w/ memIterator.
-func (store *Store) dirtyItems(start, end []byte) {
-	unsorted := make([]*tmkv.Pair, 0)
-
-	for key := range store.unsortedCache {
-		cacheValue := store.cache[key]
-
-		if dbm.IsKeyInDomain([]byte(key), start, end) {
-			unsorted = append(unsorted, &tmkv.Pair{Key: []byte(key), Value: cacheValue.value})
-
-			delete(store.unsortedCache, key)
-		}
-	}
-
-	sort.Slice(unsorted, func(i, j int) bool {
-		return bytes.Compare(unsorted[i].Key, unsorted[j].Key) < 0
-	})
-
-	for e := store.sortedCache.Front(); e != nil && len(unsorted) != 0; {
-		uitem := unsorted[0]
-		sitem := e.Value.(*tmkv.Pair)
-		comp := bytes.Compare(uitem.Key, sitem.Key)
-
-		switch comp {
-		case -1:
-			unsorted = unsorted[1:]
-
-			store.sortedCache.InsertBefore(uitem, e)
-		case 1:
-			e = e.Next()
-		case 0:
-			unsorted = unsorted[1:]
-			e.Value = uitem
-			e = e.Next()
-		}
-	}
-
-	for _, kvp := range unsorted {
-		store.sortedCache.PushBack(kvp)
-	}
-}
-
 //----------------------------------------
 // etc
 
 // Only entrypoint to mutate store.cache.
 func (store *Store) setCacheValue(key, value []byte, deleted bool, dirty bool) {
-	store.cache[string(key)] = &cValue{
+	keyStr := string(key)
+	store.cache[keyStr] = &cValue{
 		value:   value,
 		deleted: deleted,
 		dirty:   dirty,
 	}
 	if dirty {
-		store.unsortedCache[string(key)] = struct{}{}
+		store.dirty.ReplaceOrInsert(&dirtyItem{key: []byte(keyStr), value: value})
 	}
 }
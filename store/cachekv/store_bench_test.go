@@ -2,6 +2,7 @@ package cachekv_test
 
 import (
 	"crypto/rand"
+	"fmt"
 	"sort"
 	"testing"
 
@@ -44,3 +45,75 @@ func BenchmarkCacheKVStoreIterator1000(b *testing.B)   { benchmarkCacheKVStoreIt
 func BenchmarkCacheKVStoreIterator10000(b *testing.B)  { benchmarkCacheKVStoreIterator(10000, b) }
 func BenchmarkCacheKVStoreIterator50000(b *testing.B)  { benchmarkCacheKVStoreIterator(50000, b) }
 func BenchmarkCacheKVStoreIterator100000(b *testing.B) { benchmarkCacheKVStoreIterator(100000, b) }
+
+// benchmarkCacheKVStoreIteratorDirtyRange benchmarks iterating over a small
+// range of a cache-wrapped store with numKVs dirty entries.
+func benchmarkCacheKVStoreIteratorDirtyRange(numKVs int, b *testing.B) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	cstore := cachekv.NewStore(mem)
+
+	for i := 0; i < numKVs; i++ {
+		cstore.Set(benchKey(i), benchKey(i))
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		// a write between iterations dirties the store again
+		cstore.Set(benchKey(n%numKVs), benchKey(n))
+
+		start := (n * 7919) % numKVs
+		iter := cstore.Iterator(benchKey(start), benchKey(start+10))
+
+		for ; iter.Valid(); iter.Next() {
+		}
+
+		iter.Close()
+	}
+}
+
+func BenchmarkCacheKVStoreIteratorDirtyRange1000(b *testing.B) {
+	benchmarkCacheKVStoreIteratorDirtyRange(1000, b)
+}
+
+func BenchmarkCacheKVStoreIteratorDirtyRange10000(b *testing.B) {
+	benchmarkCacheKVStoreIteratorDirtyRange(10000, b)
+}
+
+func BenchmarkCacheKVStoreIteratorDirtyRange100000(b *testing.B) {
+	benchmarkCacheKVStoreIteratorDirtyRange(100000, b)
+}
+
+// benchmarkCacheKVStoreNestedWrite benchmarks writing numKVs entries through
+// depth nested cache-wrapped stores, as done by the ante handler, runMsgs and
+// module level cache contexts.
+func benchmarkCacheKVStoreNestedWrite(numKVs, depth int, b *testing.B) {
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		stores := []*cachekv.Store{cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})}
+		for i := 1; i < depth; i++ {
+			stores = append(stores, cachekv.NewStore(stores[i-1]))
+		}
+
+		for i := 0; i < numKVs; i++ {
+			stores[depth-1].Set(benchKey(i), benchKey(i))
+		}
+
+		for i := depth - 1; i > 0; i-- {
+			stores[i].Write()
+		}
+	}
+}
+
+func BenchmarkCacheKVStoreNestedWrite1000(b *testing.B) {
+	benchmarkCacheKVStoreNestedWrite(1000, 4, b)
+}
+
+func BenchmarkCacheKVStoreNestedWrite10000(b *testing.B) {
+	benchmarkCacheKVStoreNestedWrite(10000, 4, b)
+}
+
+func benchKey(i int) []byte {
+	return []byte(fmt.Sprintf("key%08d", i))
+}
@@ -150,6 +150,83 @@ func TestCacheKVIteratorBounds(t *testing.T) {
 	require.Equal(t, 4, i)
 }
 
+func TestCacheKVIteratorLargeRanges(t *testing.T) {
+	st := newCacheKVStore()
+
+	// more items than read by the cache iterator at once, with every third
+	// one deleted
+	nItems := 500
+	for i := 0; i < nItems; i++ {
+		st.Set(keyFmt(i), valFmt(i))
+	}
+
+	for i := 0; i < nItems; i += 3 {
+		st.Delete(keyFmt(i))
+	}
+
+	for _, r := range [][2]int{{0, nItems}, {1, 200}, {64, 129}, {250, 251}, {300, 300}} {
+		var expected [][]byte
+
+		for i := r[0]; i < r[1]; i++ {
+			if i%3 != 0 {
+				expected = append(expected, keyFmt(i))
+			}
+		}
+
+		var keys [][]byte
+
+		itr := st.Iterator(keyFmt(r[0]), keyFmt(r[1]))
+		for ; itr.Valid(); itr.Next() {
+			keys = append(keys, itr.Key())
+		}
+
+		itr.Close()
+		require.Equal(t, expected, keys, "range %v", r)
+
+		keys = nil
+
+		itr = st.ReverseIterator(keyFmt(r[0]), keyFmt(r[1]))
+		for ; itr.Valid(); itr.Next() {
+			keys = append([][]byte{itr.Key()}, keys...)
+		}
+
+		itr.Close()
+		require.Equal(t, expected, keys, "reverse range %v", r)
+	}
+}
+
+func TestCacheKVIteratorSnapshot(t *testing.T) {
+	st := newCacheKVStore()
+
+	for i := 0; i < 200; i++ {
+		st.Set(keyFmt(i), valFmt(i))
+	}
+
+	itr := st.Iterator(nil, nil)
+	rItr := st.ReverseIterator(nil, nil)
+
+	// writes after creating the iterators are not seen by them
+	st.Set(keyFmt(100), valFmt(0))
+	st.Delete(keyFmt(150))
+	st.Set(keyFmt(300), valFmt(300))
+	st.Write()
+
+	for i := 0; i < 200; i++ {
+		require.True(t, itr.Valid())
+		require.Equal(t, keyFmt(i), itr.Key())
+		require.Equal(t, valFmt(i), itr.Value())
+		itr.Next()
+
+		require.True(t, rItr.Valid())
+		require.Equal(t, keyFmt(199-i), rItr.Key())
+		require.Equal(t, valFmt(199-i), rItr.Value())
+		rItr.Next()
+	}
+
+	require.False(t, itr.Valid())
+	require.False(t, rItr.Valid())
+}
+
 func TestCacheKVMergeIteratorBasics(t *testing.T) {
 	st := newCacheKVStore()
 
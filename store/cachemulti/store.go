@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -25,16 +26,20 @@ type Store struct {
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CacheMultiStore = Store{}
 
 // NewFromKVStore creates a new Store object from a mapping of store keys to
 // CacheWrapper objects and a KVStore as the database. Each CacheWrapper store
-// is cache-wrapped.
+// is cache-wrapped. The given listeners are notified of writes made to the
+// KVStores of the returned Store, see AddListeners.
 func NewFromKVStore(
 	store types.KVStore, stores map[types.StoreKey]types.CacheWrapper,
 	keys map[string]types.StoreKey, traceWriter io.Writer, traceContext types.TraceContext,
+	listeners map[types.StoreKey][]types.WriteListener,
 ) Store {
 	cms := Store{
 		db:           cachekv.NewStore(store),
@@ -42,6 +47,11 @@ func NewFromKVStore(
 		keys:         keys,
 		traceWriter:  traceWriter,
 		traceContext: traceContext,
+		listeners:    make(map[types.StoreKey][]types.WriteListener, len(listeners)),
+	}
+
+	for key, ls := range listeners {
+		cms.listeners[key] = append([]types.WriteListener(nil), ls...)
 	}
 
 	for key, store := range stores {
@@ -59,19 +69,27 @@ func NewFromKVStore(
 // CacheWrapper objects. Each CacheWrapper store is cache-wrapped.
 func NewStore(
 	db dbm.DB, stores map[types.StoreKey]types.CacheWrapper, keys map[string]types.StoreKey,
-	traceWriter io.Writer, traceContext types.TraceContext,
+	traceWriter io.Writer, traceContext types.TraceContext, listeners map[types.StoreKey][]types.WriteListener,
 ) Store {
 
-	return NewFromKVStore(dbadapter.Store{DB: db}, stores, keys, traceWriter, traceContext)
+	return NewFromKVStore(dbadapter.Store{DB: db}, stores, keys, traceWriter, traceContext, listeners)
 }
 
+// newCacheMultiStoreFromCMS cache-wraps the stores of the given Store. The
+// listeners are not carried over to the new Store. Instead, stores with
+// listeners are wrapped so that writes are passed on to the listeners of the
+// parent Store when the new Store is written.
 func newCacheMultiStoreFromCMS(cms Store) Store {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range cms.stores {
-		stores[k] = v
+		if cms.ListeningEnabled(k) {
+			stores[k] = listenkv.NewStore(v.(types.KVStore), k, cms.listeners[k])
+		} else {
+			stores[k] = v
+		}
 	}
 
-	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
+	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext, nil)
 }
 
 // SetTracer sets the tracer for the MultiStore that the underlying
@@ -102,6 +120,18 @@ func (cms Store) TracingEnabled() bool {
 	return cms.traceWriter != nil
 }
 
+// ListeningEnabled returns if listening is enabled for a specific KVStore.
+func (cms Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(cms.listeners[key]) != 0
+}
+
+// AddListeners adds listeners for a specific KVStore. Writes made to the
+// KVStore, either directly or by writing a cache-wrapped MultiStore derived
+// from this one, are passed on to the listeners in the order they are applied.
+func (cms Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	cms.listeners[key] = append(cms.listeners[key], listeners...)
+}
+
 // GetStoreType returns the type of the store.
 func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -141,7 +171,11 @@ func (cms Store) CacheMultiStoreWithVersion(_ int64) (types.CacheMultiStore, err
 
 // GetStore returns an underlying Store by key.
 func (cms Store) GetStore(key types.StoreKey) types.Store {
-	return cms.stores[key].(types.Store)
+	store := cms.stores[key].(types.Store)
+	if cms.ListeningEnabled(key) {
+		return listenkv.NewStore(store.(types.KVStore), key, cms.listeners[key])
+	}
+	return store
 }
 
 // GetKVStore returns an underlying KVStore by key.
@@ -150,5 +184,8 @@ func (cms Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if key == nil {
 		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
 	}
+	if cms.ListeningEnabled(key) {
+		return listenkv.NewStore(store.(types.KVStore), key, cms.listeners[key])
+	}
 	return store.(types.KVStore)
 }
@@ -1,6 +1,8 @@
 package iavl
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
@@ -176,6 +178,31 @@ func (st *Store) DeleteVersions(versions ...int64) error {
 	return st.tree.DeleteVersions(versions...)
 }
 
+// Export exports the IAVL store at the given version, returning an iavl.Exporter for the tree.
+func (st *Store) Export(version int64) (*iavl.Exporter, error) {
+	istore, err := st.GetImmutable(version)
+	if err != nil {
+		return nil, fmt.Errorf("iavl export failed for version %v: %w", version, err)
+	}
+
+	tree, ok := istore.tree.(*immutableTree)
+	if !ok || tree == nil {
+		return nil, fmt.Errorf("iavl export failed: unable to fetch tree for version %v", version)
+	}
+
+	return tree.Export(), nil
+}
+
+// Import imports an IAVL tree at the given version, returning an iavl.Importer for importing.
+func (st *Store) Import(version int64) (*iavl.Importer, error) {
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return nil, errors.New("iavl import failed: unable to find mutable tree")
+	}
+
+	return tree.Import(version)
+}
+
 // Implements types.KVStore.
 func (st *Store) Iterator(start, end []byte) types.Iterator {
 	var iTree *iavl.ImmutableTree
@@ -273,14 +300,44 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		subspace := req.Data
 		res.Key = subspace
 
-		iterator := types.KVStorePrefixIterator(st, subspace)
-		for ; iterator.Valid(); iterator.Next() {
-			KVs = append(KVs, types.KVPair{Key: iterator.Key(), Value: iterator.Value()})
+		if !req.Prove {
+			iterator := types.KVStorePrefixIterator(st, subspace)
+			for ; iterator.Valid(); iterator.Next() {
+				KVs = append(KVs, types.KVPair{Key: iterator.Key(), Value: iterator.Value()})
+			}
+
+			iterator.Close()
+			res.Value = cdc.MustMarshalBinaryBare(KVs)
+
+			break
+		}
+
+		// A proven subspace must be read from the version it is proven against
+		if !st.VersionExists(res.Height) {
+			res.Log = iavl.ErrVersionDoesNotExist.Error()
+			break
+		}
+
+		iTree, err := tree.GetImmutable(res.Height)
+		if err != nil {
+			// sanity check: If the version exists, the immutable tree must be retrievable
+			panic(fmt.Sprintf("version exists in store but could not retrieve corresponding versioned tree in store, %s", err.Error()))
 		}
 
-		iterator.Close()
+		iTree.IterateRange(subspace, types.PrefixEndBytes(subspace), true, func(key, value []byte) bool {
+			KVs = append(KVs, types.KVPair{Key: key, Value: value})
+			return false
+		})
+
 		res.Value = cdc.MustMarshalBinaryBare(KVs)
 
+		mtree := &iavl.MutableTree{
+			ImmutableTree: iTree,
+		}
+
+		// prove that the pairs are all the pairs of the subspace
+		res.Proof = getRangeProofFromTree(mtree, subspace, KVs)
+
 	default:
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path))
 	}
@@ -317,6 +374,47 @@ func getProofFromTree(tree *iavl.MutableTree, key []byte, exists bool) *merkle.P
 	return &merkle.Proof{Ops: []merkle.ProofOp{op.ProofOp()}}
 }
 
+// getRangeProofFromTree takes a MutableTree, a prefix and the key-value pairs of
+// the tree with that prefix, in ascending key order, and returns the merkle.Proof
+// that they are all the pairs of the tree with that prefix. It panics on error,
+// as the pairs must have been read from the tree.
+func getRangeProofFromTree(tree *iavl.MutableTree, prefix []byte, kvs []types.KVPair) *merkle.Proof {
+	var proofs []*ics23.CommitmentProof
+
+	prove := func(proof *ics23.CommitmentProof, err error) {
+		if err != nil {
+			// sanity check: The pairs were read from the tree, so proofs must be creatable
+			panic(fmt.Sprintf("unexpected error for range proof: %s", err.Error()))
+		}
+
+		proofs = append(proofs, proof)
+	}
+
+	// prove that no key with the prefix lies before the first pair
+	if len(kvs) == 0 || !bytes.Equal(kvs[0].Key, prefix) {
+		prove(ics23iavl.CreateNonMembershipProof(tree, prefix))
+	}
+
+	for i, kv := range kvs {
+		prove(ics23iavl.CreateMembershipProof(tree, kv.Key))
+
+		// prove that no key lies between this pair and the next one or, for the
+		// last pair, that no key with the prefix lies after it
+		next := append(append(make([]byte, 0, len(kv.Key)+1), kv.Key...), 0)
+		if i+1 == len(kvs) || !bytes.Equal(kvs[i+1].Key, next) {
+			prove(ics23iavl.CreateNonMembershipProof(tree, next))
+		}
+	}
+
+	commitmentProof, err := ics23.CombineProofs(proofs)
+	if err != nil {
+		panic(fmt.Sprintf("unexpected error for range proof: %s", err.Error()))
+	}
+
+	op := types.NewIavlRangeOp(prefix, commitmentProof)
+	return &merkle.Proof{Ops: []merkle.ProofOp{op.ProofOp()}}
+}
+
 //----------------------------------------
 
 // Implements types.Iterator.
@@ -0,0 +1,237 @@
+package iavl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Corruption describes a node of an IAVL tree that is missing from the
+// database or whose content does not match the hash it is referenced by. The
+// path of the node is the sequence of branches, L for left and R for right,
+// taken from the root to reach it.
+type Corruption struct {
+	Path string
+	Hash []byte
+	Err  error
+}
+
+func (c Corruption) String() string {
+	path := c.Path
+	if path == "" {
+		path = "root"
+	}
+
+	return fmt.Sprintf("node %s (%X): %s", path, c.Hash, c.Err)
+}
+
+// TreeVerification is the result of verifying a version of an IAVL tree.
+type TreeVerification struct {
+	// RootHash is the root hash saved for the version, which is empty if the
+	// tree is empty.
+	RootHash    []byte
+	Nodes       int64
+	Corruptions []Corruption
+}
+
+// VerifyTree walks the tree of the given version saved to the given database,
+// which must not be prefixed by the IAVL key prefixes, and recomputes the hash
+// of each node from its content. Nodes that are missing, fail to decode, do not
+// match their hashes, or whose heights or sizes are inconsistent with their
+// children are reported as corruptions. An error is returned if the version does
+// not exist or the database fails to be read.
+func VerifyTree(db dbm.DB, version int64) (TreeVerification, error) {
+	var res TreeVerification
+
+	rootKey := make([]byte, 9)
+	rootKey[0] = 'r'
+	binary.BigEndian.PutUint64(rootKey[1:], uint64(version))
+
+	rootHash, err := db.Get(rootKey)
+	if err != nil {
+		return res, err
+	}
+
+	if rootHash == nil {
+		return res, fmt.Errorf("version %d does not exist", version)
+	}
+
+	res.RootHash = rootHash
+
+	if len(rootHash) == 0 {
+		return res, nil
+	}
+
+	v := &treeVerifier{db: db, res: &res}
+	if _, _, _, err := v.verify(rootHash, ""); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+type treeVerifier struct {
+	db  dbm.DB
+	res *TreeVerification
+}
+
+func (v *treeVerifier) corrupt(path string, hash []byte, err error) {
+	v.res.Corruptions = append(v.res.Corruptions, Corruption{Path: path, Hash: hash, Err: err})
+}
+
+// verify verifies the subtree of the node of the given hash, and returns its
+// height and size, and whether it was found intact.
+func (v *treeVerifier) verify(hash []byte, path string) (height int8, size int64, ok bool, err error) {
+	bz, err := v.db.Get(append([]byte{'n'}, hash...))
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	if bz == nil {
+		v.corrupt(path, hash, fmt.Errorf("node is missing"))
+		return 0, 0, false, nil
+	}
+
+	v.res.Nodes++
+
+	node, err := decodeNode(bz)
+	if err != nil {
+		v.corrupt(path, hash, err)
+		return 0, 0, false, nil
+	}
+
+	ok = true
+
+	if computed := node.hash(); !bytes.Equal(computed, hash) {
+		v.corrupt(path, hash, fmt.Errorf("node content hashes to %X", computed))
+		ok = false
+	}
+
+	if node.height == 0 {
+		if node.size != 1 {
+			v.corrupt(path, hash, fmt.Errorf("leaf node has size %d", node.size))
+			ok = false
+		}
+
+		return node.height, node.size, ok, nil
+	}
+
+	leftHeight, leftSize, leftOK, err := v.verify(node.leftHash, path+"L")
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	rightHeight, rightSize, rightOK, err := v.verify(node.rightHash, path+"R")
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	// the height and size of a node can only be checked against intact children
+	if !leftOK || !rightOK {
+		return node.height, node.size, false, nil
+	}
+
+	if maxHeight := maxInt8(leftHeight, rightHeight) + 1; node.height != maxHeight {
+		v.corrupt(path, hash, fmt.Errorf("node has height %d, expected %d", node.height, maxHeight))
+		ok = false
+	}
+
+	if node.size != leftSize+rightSize {
+		v.corrupt(path, hash, fmt.Errorf("node has size %d, expected %d", node.size, leftSize+rightSize))
+		ok = false
+	}
+
+	return node.height, node.size, ok, nil
+}
+
+// rawNode is an IAVL node as saved to the database.
+type rawNode struct {
+	height    int8
+	size      int64
+	version   int64
+	key       []byte
+	value     []byte
+	leftHash  []byte
+	rightHash []byte
+}
+
+// decodeNode decodes a node as encoded by the IAVL library.
+func decodeNode(bz []byte) (*rawNode, error) {
+	var (
+		node rawNode
+		n    int
+		err  error
+	)
+
+	if node.height, n, err = amino.DecodeInt8(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode height: %w", err)
+	}
+	bz = bz[n:]
+
+	if node.size, n, err = amino.DecodeVarint(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode size: %w", err)
+	}
+	bz = bz[n:]
+
+	if node.version, n, err = amino.DecodeVarint(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode version: %w", err)
+	}
+	bz = bz[n:]
+
+	if node.key, n, err = amino.DecodeByteSlice(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode key: %w", err)
+	}
+	bz = bz[n:]
+
+	if node.height == 0 {
+		if node.value, _, err = amino.DecodeByteSlice(bz); err != nil {
+			return nil, fmt.Errorf("failed to decode value: %w", err)
+		}
+
+		return &node, nil
+	}
+
+	if node.leftHash, n, err = amino.DecodeByteSlice(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode left hash: %w", err)
+	}
+	bz = bz[n:]
+
+	if node.rightHash, _, err = amino.DecodeByteSlice(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode right hash: %w", err)
+	}
+
+	return &node, nil
+}
+
+// hash computes the hash of a node as the IAVL library does, from the hashes
+// of its children for inner nodes and the hash of its value for leaves.
+func (node *rawNode) hash() []byte {
+	var buf bytes.Buffer
+
+	// writes to a bytes.Buffer never fail
+	_ = amino.EncodeInt8(&buf, node.height)
+	_ = amino.EncodeVarint(&buf, node.size)
+	_ = amino.EncodeVarint(&buf, node.version)
+
+	if node.height == 0 {
+		_ = amino.EncodeByteSlice(&buf, node.key)
+		_ = amino.EncodeByteSlice(&buf, tmhash.Sum(node.value))
+	} else {
+		_ = amino.EncodeByteSlice(&buf, node.leftHash)
+		_ = amino.EncodeByteSlice(&buf, node.rightHash)
+	}
+
+	return tmhash.Sum(buf.Bytes())
+}
+
+func maxInt8(a, b int8) int8 {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,121 @@
+package iavl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func newVerifiedTree(t *testing.T, db dbm.DB, n int) []byte {
+	tree, err := iavl.NewMutableTree(db, 100)
+	require.NoError(t, err)
+
+	for i := 0; i < n; i++ {
+		tree.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+
+	hash, _, err := tree.SaveVersion()
+	require.NoError(t, err)
+
+	return hash
+}
+
+// nodeKeys returns the database keys of the nodes of a tree, leaves first.
+func nodeKeys(t *testing.T, db dbm.DB) [][]byte {
+	var leaves, inner [][]byte
+
+	itr, err := dbm.IteratePrefix(db, []byte{'n'})
+	require.NoError(t, err)
+
+	for ; itr.Valid(); itr.Next() {
+		node, err := decodeNode(itr.Value())
+		require.NoError(t, err)
+
+		if node.height == 0 {
+			leaves = append(leaves, append([]byte{}, itr.Key()...))
+		} else {
+			inner = append(inner, append([]byte{}, itr.Key()...))
+		}
+	}
+
+	itr.Close()
+
+	return append(leaves, inner...)
+}
+
+func TestVerifyTree(t *testing.T) {
+	db := dbm.NewMemDB()
+	hash := newVerifiedTree(t, db, 20)
+
+	res, err := VerifyTree(db, 1)
+	require.NoError(t, err)
+	require.Equal(t, hash, res.RootHash)
+	require.Equal(t, int64(39), res.Nodes)
+	require.Empty(t, res.Corruptions)
+
+	_, err = VerifyTree(db, 2)
+	require.Error(t, err)
+}
+
+func TestVerifyTreeEmpty(t *testing.T) {
+	db := dbm.NewMemDB()
+	newVerifiedTree(t, db, 0)
+
+	res, err := VerifyTree(db, 1)
+	require.NoError(t, err)
+	require.Empty(t, res.RootHash)
+	require.Zero(t, res.Nodes)
+	require.Empty(t, res.Corruptions)
+}
+
+func TestVerifyTreeCorrupted(t *testing.T) {
+	db := dbm.NewMemDB()
+	newVerifiedTree(t, db, 20)
+
+	keys := nodeKeys(t, db)
+
+	// alter the value of a leaf
+	bz, err := db.Get(keys[0])
+	require.NoError(t, err)
+	bz = append([]byte{}, bz...)
+	bz[len(bz)-1]++
+	require.NoError(t, db.Set(keys[0], bz))
+
+	res, err := VerifyTree(db, 1)
+	require.NoError(t, err)
+	require.Len(t, res.Corruptions, 1)
+	require.Equal(t, keys[0][1:], res.Corruptions[0].Hash)
+	require.NotEmpty(t, res.Corruptions[0].Path)
+	require.Contains(t, res.Corruptions[0].Err.Error(), "node content hashes to")
+
+	// delete an inner node, whose subtree can no longer be walked
+	require.NoError(t, db.Delete(keys[len(keys)-1]))
+
+	res, err = VerifyTree(db, 1)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Corruptions)
+
+	var missing bool
+	for _, c := range res.Corruptions {
+		if c.Err.Error() == "node is missing" {
+			missing = true
+		}
+	}
+	require.True(t, missing)
+}
+
+func TestVerifyTreeUndecodable(t *testing.T) {
+	db := dbm.NewMemDB()
+	newVerifiedTree(t, db, 20)
+
+	keys := nodeKeys(t, db)
+	require.NoError(t, db.Set(keys[0], []byte{0xff}))
+
+	res, err := VerifyTree(db, 1)
+	require.NoError(t, err)
+	require.Len(t, res.Corruptions, 1)
+	require.Contains(t, res.Corruptions[0].Err.Error(), "failed to decode")
+}
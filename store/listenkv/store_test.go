@@ -0,0 +1,140 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+var kvPairs = []types.KVPair{
+	{Key: keyFmt(1), Value: valFmt(1)},
+	{Key: keyFmt(2), Value: valFmt(2)},
+	{Key: keyFmt(3), Value: valFmt(3)},
+}
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+func newListenKVStore(w io.Writer) *listenkv.Store {
+	store := newEmptyListenKVStore(w)
+
+	for _, kvPair := range kvPairs {
+		store.Set(kvPair.Key, kvPair.Value)
+	}
+
+	return store
+}
+
+func newEmptyListenKVStore(w io.Writer) *listenkv.Store {
+	listener := types.NewStoreKVPairWriteListener(w)
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func readKVPairs(t *testing.T, buf *bytes.Buffer) []*types.StoreKVPair {
+	reader := protoio.NewDelimitedReader(buf, 1<<20)
+	pairs := []*types.StoreKVPair{}
+
+	for {
+		pair := &types.StoreKVPair{}
+		err := reader.ReadMsg(pair)
+		if err == io.EOF {
+			return pairs
+		}
+		require.NoError(t, err)
+		pairs = append(pairs, pair)
+	}
+}
+
+func TestListenKVStoreGet(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newListenKVStore(&buf)
+	buf.Reset()
+
+	require.Equal(t, kvPairs[0].Value, store.Get(kvPairs[0].Key))
+	require.Nil(t, store.Get(keyFmt(9)))
+	require.True(t, store.Has(kvPairs[1].Key))
+	require.Zero(t, buf.Len(), "reads should not be passed to listeners")
+}
+
+func TestListenKVStoreSet(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newEmptyListenKVStore(&buf)
+	for _, kvPair := range kvPairs {
+		store.Set(kvPair.Key, kvPair.Value)
+	}
+
+	pairs := readKVPairs(t, &buf)
+	require.Len(t, pairs, len(kvPairs))
+
+	for i, kvPair := range kvPairs {
+		require.Equal(t, &types.StoreKVPair{
+			StoreKey: testStoreKey.Name(),
+			Delete:   false,
+			Key:      kvPair.Key,
+			Value:    kvPair.Value,
+		}, pairs[i])
+	}
+
+	require.Panics(t, func() { store.Set(nil, []byte("value")) }, "setting a nil key should panic")
+	require.Panics(t, func() { store.Set([]byte("key"), nil) }, "setting a nil value should panic")
+	require.Zero(t, buf.Len(), "failed writes should not be passed to listeners")
+}
+
+func TestListenKVStoreDelete(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newListenKVStore(&buf)
+	buf.Reset()
+
+	store.Delete(kvPairs[0].Key)
+	require.Nil(t, store.Get(kvPairs[0].Key))
+
+	require.Equal(t, []*types.StoreKVPair{{
+		StoreKey: testStoreKey.Name(),
+		Delete:   true,
+		Key:      kvPairs[0].Key,
+	}}, readKVPairs(t, &buf))
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newEmptyListenKVStore(&buf)
+	cache := store.CacheWrap().(types.CacheKVStore)
+
+	cache.Set(kvPairs[0].Key, kvPairs[0].Value)
+	cache.Delete(kvPairs[0].Key)
+	cache.Set(kvPairs[1].Key, kvPairs[1].Value)
+	require.Zero(t, buf.Len(), "cached writes should not be passed to listeners")
+
+	cache.Write()
+	pairs := readKVPairs(t, &buf)
+	require.Len(t, pairs, 2)
+	require.True(t, pairs[0].Delete)
+	require.Equal(t, kvPairs[0].Key, pairs[0].Key)
+	require.False(t, pairs[1].Delete)
+	require.Equal(t, kvPairs[1].Value, pairs[1].Value)
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := newEmptyListenKVStore(nil)
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
@@ -0,0 +1,257 @@
+package profilekv
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// DefaultPrefixLength is the default length of the key prefixes statistics are
+// grouped by. Modules usually prefix their keys with a single byte.
+const DefaultPrefixLength = 1
+
+// MaxIteratorRanges is the maximum number of distinct iterator ranges recorded
+// per store. Iterators opened on other ranges once it is reached are only
+// counted, so that the memory held by a Profiler recording the accesses made to
+// the stores of a running node stays bounded.
+const MaxIteratorRanges = 1024
+
+type (
+	// Profiler collects statistics of the keys of stores and of the accesses
+	// made to them, by store key and by key prefix. It is safe for concurrent
+	// use.
+	Profiler struct {
+		mtx          sync.Mutex
+		prefixLength int
+		stores       map[string]*storeProfile
+	}
+
+	// PrefixStats are the statistics of the keys of a store sharing a prefix.
+	// Keys, KeyBytes and ValueBytes describe the pairs of the store found by
+	// Scan, while the other statistics describe the accesses made through a
+	// Store.
+	PrefixStats struct {
+		Prefix     string `json:"prefix"`
+		Keys       uint64 `json:"keys"`
+		KeyBytes   uint64 `json:"key_bytes"`
+		ValueBytes uint64 `json:"value_bytes"`
+		Reads      uint64 `json:"reads"`
+		ReadBytes  uint64 `json:"read_bytes"`
+		Writes     uint64 `json:"writes"`
+		WriteBytes uint64 `json:"write_bytes"`
+		Deletes    uint64 `json:"deletes"`
+		Iterators  uint64 `json:"iterators"`
+	}
+
+	// IteratorRange is a range of keys iterated over, with the number of
+	// iterators opened on it. Its bounds are hex encoded, and empty if unbounded.
+	IteratorRange struct {
+		Start string `json:"start"`
+		End   string `json:"end"`
+		Count uint64 `json:"count"`
+	}
+
+	// StoreStats are the statistics of a store, in total and by key prefix.
+	// OtherIterators is the number of iterators opened on ranges not recorded
+	// in IteratorRanges, once MaxIteratorRanges ranges were recorded.
+	StoreStats struct {
+		StoreKey       string          `json:"store_key"`
+		Total          PrefixStats     `json:"total"`
+		Prefixes       []PrefixStats   `json:"prefixes"`
+		IteratorRanges []IteratorRange `json:"iterator_ranges"`
+		OtherIterators uint64          `json:"other_iterators"`
+	}
+
+	// Report is the statistics collected by a Profiler.
+	Report struct {
+		Height int64        `json:"height,omitempty"`
+		Stores []StoreStats `json:"stores"`
+	}
+
+	storeProfile struct {
+		prefixes map[string]*PrefixStats
+		ranges   map[[2]string]uint64
+		// otherIterators counts the iterators opened on ranges not in ranges
+		otherIterators uint64
+	}
+)
+
+// NewProfiler returns a Profiler grouping statistics by key prefixes of the
+// given length.
+func NewProfiler(prefixLength int) *Profiler {
+	return &Profiler{
+		prefixLength: prefixLength,
+		stores:       make(map[string]*storeProfile),
+	}
+}
+
+// Scan records the number and size of the pairs of the given store.
+func (p *Profiler) Scan(storeKey string, store types.KVStore) {
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key, value := it.Key(), it.Value()
+
+		p.record(storeKey, key, func(s *PrefixStats) {
+			s.Keys++
+			s.KeyBytes += uint64(len(key))
+			s.ValueBytes += uint64(len(value))
+		})
+	}
+}
+
+// Report returns the statistics collected so far, with stores sorted by store
+// key and prefixes and iterator ranges sorted by their bounds.
+func (p *Profiler) Report() Report {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	report := Report{Stores: make([]StoreStats, 0, len(p.stores))}
+
+	for storeKey, profile := range p.stores {
+		stats := StoreStats{
+			StoreKey:       storeKey,
+			Prefixes:       make([]PrefixStats, 0, len(profile.prefixes)),
+			IteratorRanges: make([]IteratorRange, 0, len(profile.ranges)),
+			OtherIterators: profile.otherIterators,
+		}
+
+		for _, s := range profile.prefixes {
+			stats.Prefixes = append(stats.Prefixes, *s)
+			stats.Total.add(*s)
+		}
+
+		for r, count := range profile.ranges {
+			stats.IteratorRanges = append(stats.IteratorRanges, IteratorRange{Start: r[0], End: r[1], Count: count})
+		}
+
+		sort.Slice(stats.Prefixes, func(i, j int) bool { return stats.Prefixes[i].Prefix < stats.Prefixes[j].Prefix })
+		sort.Slice(stats.IteratorRanges, func(i, j int) bool {
+			ri, rj := stats.IteratorRanges[i], stats.IteratorRanges[j]
+			if ri.Start != rj.Start {
+				return ri.Start < rj.Start
+			}
+
+			return ri.End < rj.End
+		})
+
+		report.Stores = append(report.Stores, stats)
+	}
+
+	sort.Slice(report.Stores, func(i, j int) bool { return report.Stores[i].StoreKey < report.Stores[j].StoreKey })
+
+	return report
+}
+
+// record updates the statistics of the prefix of the given key in the given
+// store.
+func (p *Profiler) record(storeKey string, key []byte, update func(*PrefixStats)) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	profile := p.store(storeKey)
+
+	prefix := key
+	if len(prefix) > p.prefixLength {
+		prefix = prefix[:p.prefixLength]
+	}
+
+	s, ok := profile.prefixes[string(prefix)]
+	if !ok {
+		s = &PrefixStats{Prefix: hex.EncodeToString(prefix)}
+		profile.prefixes[string(prefix)] = s
+	}
+
+	update(s)
+}
+
+// recordIterator records an iterator opened on the given range of a store.
+func (p *Profiler) recordIterator(storeKey string, start, end []byte) {
+	p.record(storeKey, start, func(s *PrefixStats) { s.Iterators++ })
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	profile := p.store(storeKey)
+	r := [2]string{hex.EncodeToString(start), hex.EncodeToString(end)}
+
+	if _, ok := profile.ranges[r]; !ok && len(profile.ranges) >= MaxIteratorRanges {
+		profile.otherIterators++
+		return
+	}
+
+	profile.ranges[r]++
+}
+
+func (p *Profiler) store(storeKey string) *storeProfile {
+	profile, ok := p.stores[storeKey]
+	if !ok {
+		profile = &storeProfile{
+			prefixes: make(map[string]*PrefixStats),
+			ranges:   make(map[[2]string]uint64),
+		}
+		p.stores[storeKey] = profile
+	}
+
+	return profile
+}
+
+func (s *PrefixStats) add(other PrefixStats) {
+	s.Keys += other.Keys
+	s.KeyBytes += other.KeyBytes
+	s.ValueBytes += other.ValueBytes
+	s.Reads += other.Reads
+	s.ReadBytes += other.ReadBytes
+	s.Writes += other.Writes
+	s.WriteBytes += other.WriteBytes
+	s.Deletes += other.Deletes
+	s.Iterators += other.Iterators
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r Report) WriteJSON(w io.Writer) error {
+	bz, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, string(bz))
+
+	return err
+}
+
+// WriteTable writes the report as a human-readable table, with a row per key
+// prefix followed by a row with the total of each store.
+func (r Report) WriteTable(w io.Writer) error {
+	if r.Height != 0 {
+		if _, err := fmt.Fprintf(w, "height: %d\n", r.Height); err != nil {
+			return err
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	fmt.Fprintln(tw, "STORE\tPREFIX\tKEYS\tKEY BYTES\tVALUE BYTES\tREADS\tREAD BYTES\tWRITES\tWRITE BYTES\tDELETES\tITERATORS\t")
+
+	row := func(storeKey, prefix string, s PrefixStats) {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t\n", storeKey, prefix,
+			s.Keys, s.KeyBytes, s.ValueBytes, s.Reads, s.ReadBytes, s.Writes, s.WriteBytes, s.Deletes, s.Iterators)
+	}
+
+	for _, store := range r.Stores {
+		for _, s := range store.Prefixes {
+			row(store.StoreKey, s.Prefix, s)
+		}
+
+		row(store.StoreKey, "total", store.Total)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,94 @@
+package profilekv
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface with profiling enabled. Operations
+// are recorded on each core KVStore call in the statistics of the store key
+// and of the prefix of the key in the underlying Profiler.
+type Store struct {
+	parent   types.KVStore
+	storeKey string
+	profiler *Profiler
+}
+
+// NewStore returns a reference to a new profileKVStore given a parent KVStore
+// implementation, the key of the store and a Profiler.
+func NewStore(parent types.KVStore, storeKey string, profiler *Profiler) *Store {
+	return &Store{parent: parent, storeKey: storeKey, profiler: profiler}
+}
+
+// Get implements the KVStore interface. It records a read operation and
+// delegates a Get call to the parent KVStore.
+func (pkv *Store) Get(key []byte) []byte {
+	value := pkv.parent.Get(key)
+
+	pkv.profiler.record(pkv.storeKey, key, func(s *PrefixStats) {
+		s.Reads++
+		s.ReadBytes += uint64(len(value))
+	})
+
+	return value
+}
+
+// Set implements the KVStore interface. It records a write operation and
+// delegates the Set call to the parent KVStore.
+func (pkv *Store) Set(key []byte, value []byte) {
+	pkv.profiler.record(pkv.storeKey, key, func(s *PrefixStats) {
+		s.Writes++
+		s.WriteBytes += uint64(len(key) + len(value))
+	})
+
+	pkv.parent.Set(key, value)
+}
+
+// Delete implements the KVStore interface. It records a delete operation and
+// delegates the Delete call to the parent KVStore.
+func (pkv *Store) Delete(key []byte) {
+	pkv.profiler.record(pkv.storeKey, key, func(s *PrefixStats) { s.Deletes++ })
+	pkv.parent.Delete(key)
+}
+
+// Has implements the KVStore interface. It records a read operation and
+// delegates the Has call to the parent KVStore.
+func (pkv *Store) Has(key []byte) bool {
+	pkv.profiler.record(pkv.storeKey, key, func(s *PrefixStats) { s.Reads++ })
+	return pkv.parent.Has(key)
+}
+
+// Iterator implements the KVStore interface. It records the iterated range and
+// delegates the Iterator call to the parent KVStore.
+func (pkv *Store) Iterator(start, end []byte) types.Iterator {
+	pkv.profiler.recordIterator(pkv.storeKey, start, end)
+	return pkv.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It records the iterated
+// range and delegates the ReverseIterator call to the parent KVStore.
+func (pkv *Store) ReverseIterator(start, end []byte) types.Iterator {
+	pkv.profiler.recordIterator(pkv.storeKey, start, end)
+	return pkv.parent.ReverseIterator(start, end)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (pkv *Store) GetStoreType() types.StoreType {
+	return pkv.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface.
+func (pkv *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(pkv)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (pkv *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(pkv, w, tc))
+}
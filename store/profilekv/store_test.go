@@ -0,0 +1,90 @@
+package profilekv_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/profilekv"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func TestProfileKVStore(t *testing.T) {
+	profiler := profilekv.NewProfiler(profilekv.DefaultPrefixLength)
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := profilekv.NewStore(parent, "bank", profiler)
+
+	store.Set(bz("a1"), bz("value"))
+	store.Set(bz("a2"), bz("value"))
+	store.Set(bz("b1"), bz("val"))
+	store.Delete(bz("a2"))
+
+	require.Equal(t, bz("value"), store.Get(bz("a1")))
+	require.Nil(t, store.Get(bz("a2")))
+	require.True(t, store.Has(bz("b1")))
+
+	it := store.Iterator(bz("a"), bz("b"))
+	it.Close()
+	it = store.ReverseIterator(bz("a"), bz("b"))
+	it.Close()
+
+	// accesses are recorded through cache-wrapped stores once written
+	cache := store.CacheWrap()
+	cache.(interface{ Set(key, value []byte) }).Set(bz("c1"), bz("v"))
+	cache.Write()
+
+	// scanning records the pairs of the store
+	profiler.Scan("bank", parent)
+
+	report := profiler.Report()
+	require.Len(t, report.Stores, 1)
+
+	stats := report.Stores[0]
+	require.Equal(t, "bank", stats.StoreKey)
+	require.Equal(t, []profilekv.PrefixStats{
+		{Prefix: "61", Keys: 1, KeyBytes: 2, ValueBytes: 5, Reads: 2, ReadBytes: 5, Writes: 2, WriteBytes: 14, Deletes: 1, Iterators: 2},
+		{Prefix: "62", Keys: 1, KeyBytes: 2, ValueBytes: 3, Reads: 1, Writes: 1, WriteBytes: 5},
+		{Prefix: "63", Keys: 1, KeyBytes: 2, ValueBytes: 1, Writes: 1, WriteBytes: 3},
+	}, stats.Prefixes)
+	require.Equal(t, profilekv.PrefixStats{
+		Keys: 3, KeyBytes: 6, ValueBytes: 9, Reads: 3, ReadBytes: 5, Writes: 4, WriteBytes: 22, Deletes: 1, Iterators: 2,
+	}, stats.Total)
+	require.Equal(t, []profilekv.IteratorRange{{Start: "61", End: "62", Count: 2}}, stats.IteratorRanges)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.WriteJSON(&buf))
+
+	var decoded profilekv.Report
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Equal(t, report, decoded)
+
+	buf.Reset()
+	require.NoError(t, report.WriteTable(&buf))
+	require.Contains(t, buf.String(), "STORE")
+	require.Contains(t, buf.String(), "total")
+}
+
+func TestProfilerIteratorRangesBounded(t *testing.T) {
+	profiler := profilekv.NewProfiler(profilekv.DefaultPrefixLength)
+	store := profilekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, "bank", profiler)
+
+	for i := 0; i < profilekv.MaxIteratorRanges+10; i++ {
+		it := store.Iterator([]byte{byte(i >> 8), byte(i)}, nil)
+		it.Close()
+	}
+
+	// iterators on ranges already recorded are still counted by range
+	it := store.Iterator([]byte{0, 0}, nil)
+	it.Close()
+
+	stats := profiler.Report().Stores[0]
+	require.Len(t, stats.IteratorRanges, profilekv.MaxIteratorRanges)
+	require.Equal(t, uint64(2), stats.IteratorRanges[0].Count)
+	require.Equal(t, uint64(10), stats.OtherIterators)
+	require.Equal(t, uint64(profilekv.MaxIteratorRanges+11), stats.Total.Iterators)
+}
@@ -0,0 +1,455 @@
+package rootmulti
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"io"
+	"math"
+	"sort"
+
+	iavltree "github.com/tendermint/iavl"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// The state export format is a stream of the following items, where integers
+// are unsigned varints and byte slices are prefixed with their length:
+//
+//	header:  stateExportMagic, format (integer), version (integer),
+//	         app hash (bytes)
+//	stores:  for each IAVL store, in ascending name order:
+//	         exportItemStore, name (bytes), store hash (bytes)
+//	         for each node, in the order of the IAVL exporter:
+//	           exportItemNode, key (bytes), value (bytes), height (integer),
+//	           version (integer)
+//	         exportItemChecksum, SHA-256 of the encoded node items of the store
+//	trailer: exportItemEnd
+//
+// As state sync snapshots, it contains the IAVL nodes of the stores, so that
+// the imported stores have the exported hashes. Unlike them, it is a single
+// uncompressed stream, which records the hashes of the commit info of its
+// version so that an import can be checked against them.
+const (
+	stateExportMagic  = "cosmos-sdk/state-export"
+	StateExportFormat = 2
+
+	exportItemEnd      byte = 0
+	exportItemStore    byte = 1
+	exportItemNode     byte = 2
+	exportItemChecksum byte = 3
+)
+
+// ExportVersion writes the IAVL nodes of the IAVL stores at the given version
+// to the given writer, in the state export format, with the hashes of the
+// stores and the app hash committed at the version. The version must be
+// retained by the pruning strategy. Other stores are not persisted and are not
+// exported.
+func (rs *Store) ExportVersion(version int64, w io.Writer) error {
+	if version <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot export version %d", version)
+	}
+
+	if version > rs.LastCommitID().Version {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot export future version %d", version)
+	}
+
+	cInfo, err := getCommitInfo(rs.db, version)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to load version %d", version)
+	}
+
+	storeHashes := make(map[string][]byte, len(cInfo.StoreInfos))
+	for _, si := range cInfo.StoreInfos {
+		storeHashes[si.Name] = si.Core.CommitID.Hash
+	}
+
+	var keys []types.StoreKey
+
+	for key, store := range rs.stores {
+		switch store.GetStoreType() {
+		case types.StoreTypeIAVL:
+			keys = append(keys, key)
+
+		case types.StoreTypeSMT:
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot export SMT store %q", key.Name())
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	bw := bufio.NewWriter(w)
+	ew := &exportWriter{w: bw}
+
+	ew.writeBytes([]byte(stateExportMagic))
+	ew.writeUvarint(StateExportFormat)
+	ew.writeUvarint(uint64(version))
+	ew.writeBytes(cInfo.Hash())
+
+	for _, key := range keys {
+		if err := exportStore(rs.GetCommitKVStore(key).(*iavl.Store), key.Name(), storeHashes[key.Name()], version, ew); err != nil {
+			return sdkerrors.Wrapf(err, "failed to export store %q", key.Name())
+		}
+	}
+
+	ew.writeByte(exportItemEnd)
+
+	if ew.err != nil {
+		return sdkerrors.Wrap(ew.err, "failed to write state export")
+	}
+
+	return bw.Flush()
+}
+
+// exportStore writes the nodes of a store at the given version to the state
+// export, followed by their checksum.
+func exportStore(store *iavl.Store, name string, hash []byte, version int64, ew *exportWriter) error {
+	exporter, err := store.Export(version)
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+
+	ew.writeByte(exportItemStore)
+	ew.writeBytes([]byte(name))
+	ew.writeBytes(hash)
+
+	checksum := sha256.New()
+	ew.checksum = checksum
+
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		ew.writeByte(exportItemNode)
+		ew.writeBytes(node.Key)
+		ew.writeBytes(node.Value)
+		ew.writeUvarint(uint64(node.Height))
+		ew.writeUvarint(uint64(node.Version))
+	}
+
+	ew.checksum = nil
+	ew.writeByte(exportItemChecksum)
+	ew.writeBytes(checksum.Sum(nil))
+
+	return nil
+}
+
+// ImportVersion rebuilds the IAVL stores from the given state export, at the
+// version it was exported at, and returns that version. The store must be new,
+// i.e. have no committed version, and every exported store must be mounted.
+// Mounted IAVL stores missing from the export are imported empty. Each store is
+// committed only once its nodes match its checksum, and the import fails if the
+// hash of a store or the app hash differs from the exported one, e.g. because
+// the mounted stores differ from the exporting application's. The stores
+// imported before an error remain written to the database, which should then be
+// discarded.
+func (rs *Store) ImportVersion(r io.Reader) (int64, error) {
+	if rs.LastCommitID().Version != 0 {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into store at version %d", rs.LastCommitID().Version)
+	}
+
+	er := &exportReader{r: bufio.NewReader(r)}
+
+	magic, err := er.readBytes()
+	if err != nil || string(magic) != stateExportMagic {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not a state export")
+	}
+
+	format, err := er.readUvarint()
+	if err != nil {
+		return 0, err
+	}
+
+	if format != StateExportFormat {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown state export format %d", format)
+	}
+
+	v, err := er.readUvarint()
+	if err != nil {
+		return 0, err
+	}
+
+	version := int64(v)
+	if version <= 0 {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid state export version %d", version)
+	}
+
+	appHash, err := er.readBytes()
+	if err != nil {
+		return 0, err
+	}
+
+	imported := make(map[string]bool)
+	lastName := ""
+
+	for {
+		item, err := er.readByte()
+		if err != nil {
+			return 0, err
+		}
+
+		if item == exportItemEnd {
+			break
+		}
+
+		if item != exportItemStore {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected state export item %d", item)
+		}
+
+		nameBz, err := er.readBytes()
+		if err != nil {
+			return 0, err
+		}
+
+		name := string(nameBz)
+		if len(imported) > 0 && name <= lastName {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "store %q out of order", name)
+		}
+
+		lastName = name
+		imported[name] = true
+
+		store, ok := rs.getStoreByName(name).(*iavl.Store)
+		if !ok || store == nil {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", name)
+		}
+
+		if err := importStore(store, version, er); err != nil {
+			return 0, sdkerrors.Wrapf(err, "failed to import store %q", name)
+		}
+	}
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL || imported[key.Name()] {
+			continue
+		}
+
+		importer, err := rs.GetCommitKVStore(key).(*iavl.Store).Import(version)
+		if err != nil {
+			return 0, sdkerrors.Wrapf(err, "failed to import store %q", key.Name())
+		}
+
+		if err := importer.Commit(); err != nil {
+			return 0, sdkerrors.Wrapf(err, "failed to import store %q", key.Name())
+		}
+	}
+
+	cInfo := rs.buildCommitInfo(version)
+	if !bytes.Equal(cInfo.Hash(), appHash) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "app hash %X does not match the exported app hash %X", cInfo.Hash(), appHash)
+	}
+
+	flushMetadata(rs.db, version, cInfo, []int64{})
+
+	return version, rs.LoadLatestVersion()
+}
+
+// importStore imports the nodes of a store from the state export, and commits
+// it if they match the store's checksum and hash.
+func importStore(store *iavl.Store, version int64, er *exportReader) error {
+	hash, err := er.readBytes()
+	if err != nil {
+		return err
+	}
+
+	importer, err := store.Import(version)
+	if err != nil {
+		return err
+	}
+	defer importer.Close()
+
+	checksum := sha256.New()
+
+	var item byte
+
+	for {
+		// the item following the last node is not part of the checksum
+		er.checksum = nil
+
+		if item, err = er.readByte(); err != nil {
+			return err
+		}
+
+		if item != exportItemNode {
+			break
+		}
+
+		checksum.Write([]byte{item})
+		er.checksum = checksum
+
+		node, err := readNode(er)
+		if err != nil {
+			return err
+		}
+
+		if err := importer.Add(node); err != nil {
+			return err
+		}
+	}
+
+	if item != exportItemChecksum {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expected checksum, got state export item %d", item)
+	}
+
+	expected, err := er.readBytes()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(expected, checksum.Sum(nil)) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "checksum mismatch")
+	}
+
+	if err := importer.Commit(); err != nil {
+		return err
+	}
+
+	if !bytes.Equal(store.LastCommitID().Hash, hash) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "hash %X does not match the exported hash %X", store.LastCommitID().Hash, hash)
+	}
+
+	return nil
+}
+
+// readNode reads the fields of a node item from the state export.
+func readNode(er *exportReader) (*iavltree.ExportNode, error) {
+	key, err := er.readBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := er.readBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := er.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+
+	if height > math.MaxInt8 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "node height %d cannot exceed %d", height, math.MaxInt8)
+	}
+
+	version, err := er.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+
+	// inner nodes have no value, while the value of a leaf may be empty
+	if height > 0 {
+		value = nil
+	}
+
+	return &iavltree.ExportNode{Key: key, Value: value, Height: int8(height), Version: int64(version)}, nil
+}
+
+// exportWriter writes the items of a state export, keeping the first error and
+// adding what it writes to the checksum, if any.
+type exportWriter struct {
+	w        io.Writer
+	checksum hash.Hash
+	err      error
+}
+
+func (ew *exportWriter) write(bz []byte) {
+	if ew.err != nil {
+		return
+	}
+
+	if ew.checksum != nil {
+		ew.checksum.Write(bz)
+	}
+
+	_, ew.err = ew.w.Write(bz)
+}
+
+func (ew *exportWriter) writeByte(b byte) {
+	ew.write([]byte{b})
+}
+
+func (ew *exportWriter) writeUvarint(n uint64) {
+	buf := make([]byte, binary.MaxVarintLen64)
+	ew.write(buf[:binary.PutUvarint(buf, n)])
+}
+
+func (ew *exportWriter) writeBytes(bz []byte) {
+	ew.writeUvarint(uint64(len(bz)))
+	ew.write(bz)
+}
+
+// exportReader reads the items of a state export, adding what it reads to the
+// checksum, if any.
+type exportReader struct {
+	r        *bufio.Reader
+	checksum hash.Hash
+}
+
+func (er *exportReader) readByte() (byte, error) {
+	b, err := er.r.ReadByte()
+	if err != nil {
+		return 0, sdkerrors.Wrap(noEOF(err), "failed to read state export")
+	}
+
+	if er.checksum != nil {
+		er.checksum.Write([]byte{b})
+	}
+
+	return b, nil
+}
+
+func (er *exportReader) readUvarint() (uint64, error) {
+	n, err := binary.ReadUvarint(er.r)
+	if err != nil {
+		return 0, sdkerrors.Wrap(noEOF(err), "failed to read state export")
+	}
+
+	if er.checksum != nil {
+		buf := make([]byte, binary.MaxVarintLen64)
+		er.checksum.Write(buf[:binary.PutUvarint(buf, n)])
+	}
+
+	return n, nil
+}
+
+func (er *exportReader) readBytes() ([]byte, error) {
+	n, err := er.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+
+	if n > uint64(snapshotMaxItemSize) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "state export item of %d bytes exceeds %d", n, snapshotMaxItemSize)
+	}
+
+	bz := make([]byte, n)
+	if _, err := io.ReadFull(er.r, bz); err != nil {
+		return nil, sdkerrors.Wrap(noEOF(err), "failed to read state export")
+	}
+
+	if er.checksum != nil {
+		er.checksum.Write(bz)
+	}
+
+	return bz, nil
+}
+
+// noEOF turns the end of the stream, which is never expected while reading an
+// item, into an unexpected EOF.
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
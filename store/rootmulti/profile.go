@@ -0,0 +1,47 @@
+package rootmulti
+
+import (
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/profilekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// ProfileVersion records the number and size of the pairs of the IAVL stores
+// committed to the given database at the given version, or at the latest
+// version if it is 0, in the given Profiler, and returns the version. The
+// stores are loaded by name from the commit info of the version, so that they
+// do not need to be mounted.
+func ProfileVersion(db dbm.DB, version int64, profiler *profilekv.Profiler) (int64, error) {
+	if version == 0 {
+		version = getLatestVersion(db)
+	}
+
+	cInfo, err := getCommitInfo(db, version)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, si := range cInfo.StoreInfos {
+		// only IAVL stores are loaded
+		storeDB, typ, err := committedStoreDB(db, si)
+		if err != nil {
+			return 0, err
+		}
+
+		if typ != types.StoreTypeIAVL {
+			continue
+		}
+
+		store, err := iavl.LoadStore(storeDB, si.Core.CommitID, false)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to load store %s", si.Name)
+		}
+
+		profiler.Scan(si.Name, store)
+	}
+
+	return version, nil
+}
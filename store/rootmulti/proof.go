@@ -9,10 +9,11 @@ import (
 // RequireProof returns whether proof is required for the subpath.
 func RequireProof(subpath string) bool {
 	// XXX: create a better convention.
-	// Currently, only when query subpath is "/key", will proof be included in
-	// response. If there are some changes about proof building in iavlstore.go,
-	// we must change code here to keep consistency with iavlStore#Query.
-	return subpath == "/key"
+	// Currently, only when query subpath is "/key" or "/subspace", will proof be
+	// included in response. If there are some changes about proof building in
+	// iavlstore.go, we must change code here to keep consistency with
+	// iavlStore#Query.
+	return subpath == "/key" || subpath == "/subspace"
 }
 
 //-----------------------------------------------------------------------------
@@ -22,6 +23,8 @@ func RequireProof(subpath string) bool {
 func DefaultProofRuntime() (prt *merkle.ProofRuntime) {
 	prt = merkle.NewProofRuntime()
 	prt.RegisterOpDecoder(storetypes.ProofOpIAVLCommitment, storetypes.CommitmentOpDecoder)
+	prt.RegisterOpDecoder(storetypes.ProofOpSMTCommitment, storetypes.CommitmentOpDecoder)
 	prt.RegisterOpDecoder(storetypes.ProofOpSimpleMerkleCommitment, storetypes.CommitmentOpDecoder)
+	prt.RegisterOpDecoder(storetypes.ProofOpIAVLRange, storetypes.RangeOpDecoder)
 	return
 }
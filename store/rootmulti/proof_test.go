@@ -7,6 +7,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
@@ -109,6 +110,52 @@ func TestVerifyMultiStoreQueryProof(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestVerifyMultiStoreSMTQueryProof(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := NewStore(db)
+	iavlStoreKey := types.NewKVStoreKey("iavlStoreKey")
+	smtStoreKey := types.NewKVStoreKey("smtStoreKey")
+
+	store.MountStoreWithDB(iavlStoreKey, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(smtStoreKey, types.StoreTypeSMT, nil)
+	require.NoError(t, store.LoadVersion(0))
+
+	smtStore := store.GetCommitKVStore(smtStoreKey)
+	require.Equal(t, types.StoreTypeSMT, smtStore.GetStoreType())
+	smtStore.Set([]byte("MYKEY"), []byte("MYVALUE"))
+	smtStore.Set([]byte("OTHERKEY"), []byte("OTHERVALUE"))
+	cid := store.Commit()
+
+	prt := DefaultProofRuntime()
+
+	res := store.Query(abci.RequestQuery{
+		Path:  "/smtStoreKey/key",
+		Data:  []byte("MYKEY"),
+		Prove: true,
+	})
+	require.NotNil(t, res.Proof)
+	require.Nil(t, prt.VerifyValue(res.Proof, cid.Hash, "/smtStoreKey/MYKEY", []byte("MYVALUE")))
+	require.NotNil(t, prt.VerifyValue(res.Proof, cid.Hash, "/smtStoreKey/MYKEY", []byte("MYVALUE_NOT")))
+	require.NotNil(t, prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYKEY", []byte("MYVALUE")))
+
+	res = store.Query(abci.RequestQuery{
+		Path:  "/smtStoreKey/key",
+		Data:  []byte("MYABSENTKEY"),
+		Prove: true,
+	})
+	require.NotNil(t, res.Proof)
+	require.Nil(t, prt.VerifyAbsence(res.Proof, cid.Hash, "/smtStoreKey/MYABSENTKEY"))
+	require.NotNil(t, prt.VerifyAbsence(res.Proof, cid.Hash, "/smtStoreKey/MYKEY"))
+
+	// subspaces of SMT stores cannot be proven
+	res = store.Query(abci.RequestQuery{
+		Path:  "/smtStoreKey/subspace",
+		Data:  []byte("MY"),
+		Prove: true,
+	})
+	require.True(t, res.IsErr())
+}
+
 func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	// Create main tree for testing.
 	db := dbm.NewMemDB()
@@ -146,3 +193,93 @@ func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	err = prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYABSENTKEY", []byte(""))
 	require.NotNil(t, err)
 }
+
+func TestVerifyMultiStoreSubspaceProof(t *testing.T) {
+	// Create main tree for testing.
+	db := dbm.NewMemDB()
+	store := NewStore(db)
+	iavlStoreKey := types.NewKVStoreKey("iavlStoreKey")
+
+	store.MountStoreWithDB(iavlStoreKey, types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadVersion(0))
+
+	iavlStore := store.GetCommitStore(iavlStoreKey).(*iavl.Store)
+	iavlStore.Set([]byte("A"), []byte("VALUE"))
+	iavlStore.Set([]byte("MY"), []byte("VALUE0"))
+	iavlStore.Set([]byte("MYKEY1"), []byte("VALUE1"))
+	iavlStore.Set([]byte("MYKEY2"), []byte("VALUE2"))
+	iavlStore.Set([]byte("MYKEY2\x00"), []byte("VALUE3"))
+	iavlStore.Set([]byte("Z"), []byte("VALUE"))
+	cid := store.Commit()
+
+	query := func(subspace string) (abci.ResponseQuery, []types.KVPair) {
+		res := store.Query(abci.RequestQuery{
+			Path:   "/iavlStoreKey/subspace",
+			Data:   []byte(subspace),
+			Height: cid.Version,
+			Prove:  true,
+		})
+		require.Equal(t, uint32(0), res.Code, res.Log)
+		require.NotNil(t, res.Proof)
+
+		var kvs []types.KVPair
+		require.NoError(t, codec.New().UnmarshalBinaryBare(res.Value, &kvs))
+
+		return res, kvs
+	}
+
+	args := func(kvs []types.KVPair) [][]byte {
+		var args [][]byte
+		for _, kv := range kvs {
+			args = append(args, kv.Key, kv.Value)
+		}
+
+		return args
+	}
+
+	prt := DefaultProofRuntime()
+
+	res, kvs := query("MY")
+	require.Len(t, kvs, 4)
+
+	// Verify proof.
+	err := prt.Verify(res.Proof, cid.Hash, "/iavlStoreKey/MY", args(kvs))
+	require.NoError(t, err)
+
+	// Verify (bad) proof, omitting a pair.
+	for i := range kvs {
+		omitted := append(append([]types.KVPair{}, kvs[:i]...), kvs[i+1:]...)
+		err = prt.Verify(res.Proof, cid.Hash, "/iavlStoreKey/MY", args(omitted))
+		require.Error(t, err)
+	}
+
+	// Verify (bad) proof, altering a value.
+	altered := append([]types.KVPair{}, kvs...)
+	altered[1] = types.KVPair{Key: kvs[1].Key, Value: []byte("VALUE_NOT")}
+	err = prt.Verify(res.Proof, cid.Hash, "/iavlStoreKey/MY", args(altered))
+	require.Error(t, err)
+
+	// Verify (bad) proof, adding a pair.
+	extra := append(append([]types.KVPair{}, kvs...), types.KVPair{Key: []byte("MYKEY3"), Value: []byte("VALUE")})
+	err = prt.Verify(res.Proof, cid.Hash, "/iavlStoreKey/MY", args(extra))
+	require.Error(t, err)
+
+	// Verify (bad) proof, with another subspace.
+	err = prt.Verify(res.Proof, cid.Hash, "/iavlStoreKey/MYKEY", args(kvs))
+	require.Error(t, err)
+
+	// Verify (bad) proof, with another store.
+	err = prt.Verify(res.Proof, cid.Hash, "/MY", args(kvs))
+	require.Error(t, err)
+
+	// Verify proof of an empty subspace.
+	res, kvs = query("NOKEY")
+	require.Empty(t, kvs)
+
+	err = prt.Verify(res.Proof, cid.Hash, "/iavlStoreKey/NOKEY", nil)
+	require.NoError(t, err)
+
+	// Verify (bad) proof, claiming a pair in an empty subspace.
+	err = prt.Verify(res.Proof, cid.Hash, "/iavlStoreKey/NOKEY", [][]byte{[]byte("NOKEY1"), []byte("VALUE")})
+	require.Error(t, err)
+}
@@ -0,0 +1,123 @@
+package rootmulti
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// pruneBatchSize is the number of versions deleted from an IAVL store at once,
+// bounding the size of the batches written to the database.
+const pruneBatchSize = 100
+
+// PruneVersions deletes the versions of the IAVL stores committed to the given
+// database which are not kept by the given pruning options. The latest version
+// is always kept. The stores are loaded by name from the commit info of the
+// latest version, so that they do not need to be mounted, which means that the
+// database must not be in use by a running application. It returns the heights
+// which were pruned from at least one store, in ascending order. SMT stores are
+// only pruned by the multi-store when committing.
+func PruneVersions(db dbm.DB, pruningOpts types.PruningOptions) ([]int64, error) {
+	latest := getLatestVersion(db)
+	if latest == 0 {
+		return nil, nil
+	}
+
+	cInfo, err := getCommitInfo(db, latest)
+	if err != nil {
+		return nil, err
+	}
+
+	heights := pruneHeightsUntil(latest, pruningOpts)
+	pruned := make(map[int64]bool)
+
+	for _, si := range cInfo.StoreInfos {
+		// only IAVL stores are loaded
+		storeDB, typ, err := committedStoreDB(db, si)
+		if err != nil {
+			return nil, err
+		}
+
+		if typ != types.StoreTypeIAVL {
+			continue
+		}
+
+		store, err := iavl.LoadStore(storeDB, si.Core.CommitID, false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load store %s", si.Name)
+		}
+
+		iavlStore := store.(*iavl.Store)
+
+		var versions []int64
+		for _, h := range heights {
+			if iavlStore.VersionExists(h) {
+				versions = append(versions, h)
+			}
+		}
+
+		for len(versions) > 0 {
+			n := pruneBatchSize
+			if n > len(versions) {
+				n = len(versions)
+			}
+
+			if err := iavlStore.DeleteVersions(versions[:n]...); err != nil {
+				return nil, errors.Wrapf(err, "failed to prune store %s", si.Name)
+			}
+
+			for _, h := range versions[:n] {
+				pruned[h] = true
+			}
+
+			versions = versions[n:]
+		}
+	}
+
+	// the heights pending to be pruned on the next commit may have been pruned
+	if ph, err := getPruningHeights(db); err == nil {
+		pending := make([]int64, 0, len(ph))
+		for _, h := range ph {
+			if !pruned[h] {
+				pending = append(pending, h)
+			}
+		}
+
+		batch := db.NewBatch()
+		defer batch.Close()
+
+		setPruningHeights(batch, pending)
+
+		if err := batch.Write(); err != nil {
+			return nil, errors.Wrap(err, "failed to write pruned heights")
+		}
+	}
+
+	prunedHeights := make([]int64, 0, len(pruned))
+	for h := range pruned {
+		prunedHeights = append(prunedHeights, h)
+	}
+
+	sort.Slice(prunedHeights, func(i, j int) bool { return prunedHeights[i] < prunedHeights[j] })
+
+	return prunedHeights, nil
+}
+
+// pruneHeightsUntil returns the heights before the given latest height which
+// are not kept by the given pruning options, in ascending order. These are the
+// heights Commit would have pruned had the options been in effect all along.
+func pruneHeightsUntil(latest int64, pruningOpts types.PruningOptions) []int64 {
+	var heights []int64
+
+	for h := int64(1); h < latest-int64(pruningOpts.KeepRecent); h++ {
+		if pruningOpts.KeepEvery == 0 || h%int64(pruningOpts.KeepEvery) != 0 {
+			heights = append(heights, h)
+		}
+	}
+
+	return heights
+}
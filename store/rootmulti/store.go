@@ -1,11 +1,16 @@
 package rootmulti
 
 import (
+	"bufio"
+	"compress/zlib"
 	"fmt"
 	"io"
+	"math"
+	"sort"
 	"strings"
 
 	ics23 "github.com/confio/ics23/go"
+	protoio "github.com/gogo/protobuf/io"
 	"github.com/pkg/errors"
 	iavltree "github.com/tendermint/iavl"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -13,12 +18,17 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/mem"
+	"github.com/cosmos/cosmos-sdk/store/profilekv"
 	sdkmaps "github.com/cosmos/cosmos-sdk/store/rootmulti/internal/maps"
 	sdkproofs "github.com/cosmos/cosmos-sdk/store/rootmulti/internal/proofs"
+	"github.com/cosmos/cosmos-sdk/store/smt"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -29,6 +39,11 @@ const (
 	latestVersionKey = "s/latest"
 	pruneHeightsKey  = "s/pruneheights"
 	commitInfoKeyFmt = "s/%d" // s/<version>
+
+	// Do not change chunk size without new snapshot format (must be uniform across nodes)
+	snapshotChunkSize   = uint64(10e6)
+	snapshotBufferSize  = int(snapshotChunkSize)
+	snapshotMaxItemSize = int(64e6) // SDK has no key/value size limit, so we set an arbitrary limit
 )
 
 var cdc = codec.New()
@@ -50,11 +65,18 @@ type Store struct {
 	traceContext types.TraceContext
 
 	interBlockCache types.MultiStorePersistentCache
+
+	listeners map[types.StoreKey][]types.WriteListener
+
+	profiler *profilekv.Profiler
+
+	beforePrune func(heights []int64) (deferred []int64)
 }
 
 var (
-	_ types.CommitMultiStore = (*Store)(nil)
-	_ types.Queryable        = (*Store)(nil)
+	_ types.CommitMultiStore    = (*Store)(nil)
+	_ types.Queryable           = (*Store)(nil)
+	_ snapshottypes.Snapshotter = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -79,6 +101,11 @@ func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	rs.pruningOpts = pruningOpts
 }
 
+// GetPruning fetches the pruning strategy from the root store.
+func (rs *Store) GetPruning() types.PruningOptions {
+	return rs.pruningOpts
+}
+
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
 func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
@@ -132,26 +159,36 @@ func (rs *Store) GetCommitKVStore(key types.StoreKey) types.CommitKVStore {
 // LoadLatestVersionAndUpgrade implements CommitMultiStore
 func (rs *Store) LoadLatestVersionAndUpgrade(upgrades *types.StoreUpgrades) error {
 	ver := getLatestVersion(rs.db)
-	return rs.loadVersion(ver, upgrades)
+	return rs.loadVersion(ver, upgrades, false)
 }
 
 // LoadVersionAndUpgrade allows us to rename substores while loading an older version
 func (rs *Store) LoadVersionAndUpgrade(ver int64, upgrades *types.StoreUpgrades) error {
-	return rs.loadVersion(ver, upgrades)
+	return rs.loadVersion(ver, upgrades, false)
 }
 
 // LoadLatestVersion implements CommitMultiStore.
 func (rs *Store) LoadLatestVersion() error {
 	ver := getLatestVersion(rs.db)
-	return rs.loadVersion(ver, nil)
+	return rs.loadVersion(ver, nil, false)
 }
 
 // LoadVersion implements CommitMultiStore.
 func (rs *Store) LoadVersion(ver int64) error {
-	return rs.loadVersion(ver, nil)
+	return rs.loadVersion(ver, nil, false)
 }
 
-func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
+// loadVersion loads the given version and applies the given upgrades to it.
+// Unless dryRun is set, which it is when loading a branch of the Store in
+// DryRunUpgrade, the upgrades are first applied to a branch so that a failing
+// upgrade leaves the Store untouched.
+func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades, dryRun bool) error {
+	if !upgrades.IsEmpty() && !dryRun {
+		if err := rs.DryRunUpgrade(ver, upgrades); err != nil {
+			return errors.Wrap(err, "store upgrades failed in dry run")
+		}
+	}
+
 	infos := make(map[string]storeInfo)
 	var cInfo commitInfo
 
@@ -169,6 +206,12 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		}
 	}
 
+	// the caches of the stores previously loaded are dropped, discarding the
+	// writes held by write-back caches, which were never committed
+	if rs.interBlockCache != nil {
+		rs.interBlockCache.Reset()
+	}
+
 	// load each Store (note this doesn't panic on unmounted keys now)
 	var newStores = make(map[types.StoreKey]types.CommitKVStore)
 
@@ -180,6 +223,11 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 
 		newStores[key] = store
 
+		// An added store must not exist yet
+		if _, ok := infos[key.Name()]; ok && upgrades.IsAdded(key.Name()) {
+			return fmt.Errorf("cannot add existing store %s", key.Name())
+		}
+
 		// If it was deleted, remove all data
 		if upgrades.IsDeleted(key.Name()) {
 			if err := deleteKVStore(store.(types.KVStore)); err != nil {
@@ -205,6 +253,10 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		}
 	}
 
+	if err := migrateStores(newStores, upgrades); err != nil {
+		return err
+	}
+
 	rs.lastCommitInfo = cInfo
 	rs.stores = newStores
 
@@ -255,6 +307,20 @@ func moveKVStoreData(oldDB types.KVStore, newDB types.KVStore) error {
 	return deleteKVStore(oldDB)
 }
 
+// SetProfiler sets the Profiler recording the accesses made to the stores
+// through the CacheMultiStores of the Store. As these cache the values read and
+// written, it records the accesses which reach the committed state.
+func (rs *Store) SetProfiler(p *profilekv.Profiler) {
+	rs.profiler = p
+}
+
+// SetBeforePrune sets a function called with the heights about to be pruned,
+// before any of them is deleted. The heights it returns, e.g. because they are
+// still being read, are not pruned until the next pruning.
+func (rs *Store) SetBeforePrune(fn func(heights []int64) (deferred []int64)) {
+	rs.beforePrune = fn
+}
+
 // SetInterBlockCache sets the Store's internal inter-block (persistent) cache.
 // When this is defined, all CommitKVStores will be wrapped with their respective
 // inter-block cache.
@@ -290,6 +356,26 @@ func (rs *Store) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// AddListeners adds listeners for a specific KVStore. The listeners are
+// notified of writes made through GetKVStore as well as writes made to any
+// MultiStore cache-wrapped from this one once it is written.
+//
+// NOTE: Cache-wrapped MultiStores are used for CheckTx and queries as well, so
+// applications only interested in committed state changes should add listeners
+// to the relevant CacheMultiStore instead.
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	if rs.listeners == nil {
+		rs.listeners = make(map[types.StoreKey][]types.WriteListener)
+	}
+
+	rs.listeners[key] = append(rs.listeners[key], listeners...)
+}
+
+// ListeningEnabled returns if listening is enabled for a specific KVStore.
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(rs.listeners[key]) != 0
+}
+
 //----------------------------------------
 // +CommitStore
 
@@ -332,27 +418,61 @@ func (rs *Store) Commit() types.CommitID {
 }
 
 // pruneStores will batch delete a list of heights from each mounted sub-store.
-// Afterwards, pruneHeights is reset.
+// Afterwards, pruneHeights is reset to the heights whose pruning was deferred.
 func (rs *Store) pruneStores() {
 	if len(rs.pruneHeights) == 0 {
 		return
 	}
 
+	heights := rs.pruneHeights
+	deferred := make([]int64, 0)
+
+	if rs.beforePrune != nil {
+		deferred = append(deferred, rs.beforePrune(heights)...)
+
+		if len(deferred) > 0 {
+			isDeferred := make(map[int64]bool, len(deferred))
+			for _, height := range deferred {
+				isDeferred[height] = true
+			}
+
+			heights = make([]int64, 0, len(rs.pruneHeights))
+			for _, height := range rs.pruneHeights {
+				if !isDeferred[height] {
+					heights = append(heights, height)
+				}
+			}
+		}
+	}
+
+	rs.pruneHeights = deferred
+	if len(heights) == 0 {
+		return
+	}
+
 	for key, store := range rs.stores {
 		if store.GetStoreType() == types.StoreTypeIAVL {
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
 
-			if err := store.(*iavl.Store).DeleteVersions(rs.pruneHeights...); err != nil {
+			if err := store.(*iavl.Store).DeleteVersions(heights...); err != nil {
 				if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
 					panic(err)
 				}
 			}
 		}
-	}
 
-	rs.pruneHeights = make([]int64, 0)
+		if store.GetStoreType() == types.StoreTypeSMT {
+			store = rs.GetCommitKVStore(key)
+
+			if err := store.(*smt.Store).DeleteVersions(heights...); err != nil {
+				if errCause := errors.Cause(err); errCause != nil && errCause != smt.ErrVersionDoesNotExist {
+					panic(err)
+				}
+			}
+		}
+	}
 }
 
 // CacheWrap implements CacheWrapper/Store/CommitStore.
@@ -373,10 +493,14 @@ func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
-		stores[k] = v
+		if rs.profiler != nil {
+			stores[k] = profilekv.NewStore(v, k.Name(), rs.profiler)
+		} else {
+			stores[k] = v
+		}
 	}
 
-	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
+	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext, rs.listeners)
 }
 
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
@@ -384,29 +508,12 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 // any store cannot be loaded. This should only be used for querying and
 // iterating at past heights.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
-	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
-	for key, store := range rs.stores {
-		switch store.GetStoreType() {
-		case types.StoreTypeIAVL:
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
-
-			// Attempt to lazy-load an already saved IAVL store version. If the
-			// version does not exist or is pruned, an error should be returned.
-			iavlStore, err := store.(*iavl.Store).GetImmutable(version)
-			if err != nil {
-				return nil, err
-			}
-
-			cachedStores[key] = iavlStore
-
-		default:
-			cachedStores[key] = store
-		}
+	ro, err := rs.ReadOnlyVersion(version)
+	if err != nil {
+		return nil, err
 	}
 
-	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext), nil
+	return cachemulti.NewStore(rs.db, ro.stores, rs.keysByName, rs.traceWriter, rs.traceContext, nil), nil
 }
 
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
@@ -426,7 +533,8 @@ func (rs *Store) GetStore(key types.StoreKey) types.Store {
 
 // GetKVStore returns a mounted KVStore for a given StoreKey. If tracing is
 // enabled on the KVStore, a wrapped TraceKVStore will be returned with the root
-// store's tracer, otherwise, the original KVStore will be returned.
+// store's tracer, otherwise, the original KVStore will be returned. If listening
+// is enabled on the KVStore, it is additionally wrapped in a listenkv.Store.
 //
 // NOTE: The returned KVStore may be wrapped in an inter-block cache if it is
 // set on the root store.
@@ -436,6 +544,9 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
 	}
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
 
 	return store
 }
@@ -480,7 +591,7 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	req.Path = subpath
 	res := queryable.Query(req)
 
-	if !req.Prove || !RequireProof(subpath) {
+	if !req.Prove || !RequireProof(subpath) || res.IsErr() {
 		return res
 	}
 
@@ -526,14 +637,43 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 	return storeName, subpath, nil
 }
 
+// storePrefix returns the prefix under which the store with the given name is
+// persisted in the database of a Store.
+func storePrefix(name string) []byte {
+	return []byte("s/k:" + name + "/")
+}
+
+// committedStoreDB returns the database of a store committed to the given
+// database of a Store, along with the type of the store, so that it can be
+// loaded by name without being mounted. Stores committed at version 0 are
+// memory stores, which are committed empty, and SMT stores, which share the key
+// prefixes of IAVL stores, are recognized by the version of their key index.
+func committedStoreDB(db dbm.DB, si storeInfo) (dbm.DB, types.StoreType, error) {
+	storeDB := dbm.NewPrefixDB(db, storePrefix(si.Name))
+
+	if si.Core.CommitID.Version == 0 {
+		return storeDB, types.StoreTypeMemory, nil
+	}
+
+	isSMT, err := smt.IsStore(storeDB)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if isSMT {
+		return storeDB, types.StoreTypeSMT, nil
+	}
+
+	return storeDB, types.StoreTypeIAVL, nil
+}
+
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
 	var db dbm.DB
 
 	if params.db != nil {
 		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
+		db = dbm.NewPrefixDB(rs.db, storePrefix(params.key.Name()))
 	}
 
 	switch params.typ {
@@ -555,6 +695,18 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 
 		return store, err
 
+	case types.StoreTypeSMT:
+		store, err := smt.LoadStore(db, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if rs.interBlockCache != nil {
+			store = rs.interBlockCache.GetStoreCache(key, store)
+		}
+
+		return store, nil
+
 	case types.StoreTypeDB:
 		return commitDBStoreAdapter{Store: dbadapter.Store{DB: db}}, nil
 
@@ -783,3 +935,288 @@ func flushMetadata(db dbm.DB, version int64, cInfo commitInfo, pruneHeights []in
 		panic(fmt.Errorf("error on batch write %w", err))
 	}
 }
+
+//----------------------------------------
+// Snapshots
+
+// Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
+// identical across nodes such that chunks from different sources fit together. If the output for a
+// given format changes (at the byte level), the snapshot format must be bumped - see
+// TestMultistoreSnapshot_Checksum test.
+func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	if format != snapshottypes.CurrentFormat {
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+	}
+
+	if height > uint64(rs.LastCommitID().Version) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	// Collect stores to snapshot (only IAVL stores are supported)
+	type namedStore struct {
+		*iavl.Store
+		name string
+	}
+
+	stores := []namedStore{}
+
+	for key := range rs.stores {
+		switch store := rs.GetCommitKVStore(key).(type) {
+		case *iavl.Store:
+			stores = append(stores, namedStore{name: key.Name(), Store: store})
+
+		case *transient.Store, *mem.Store:
+			// Non-persisted stores shouldn't be snapshotted
+			continue
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+				"don't know how to snapshot store %q of type %T", key.Name(), store)
+		}
+	}
+
+	sort.Slice(stores, func(i, j int) bool {
+		return strings.Compare(stores[i].name, stores[j].name) == -1
+	})
+
+	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
+	ch := make(chan io.ReadCloser)
+	go func() {
+		// Set up a stream pipeline to serialize snapshot nodes:
+		// ExportNode -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+		chunkWriter := snapshots.NewChunkWriter(ch, snapshotChunkSize)
+		defer chunkWriter.Close()
+
+		bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
+		defer func() {
+			if err := bufWriter.Flush(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+
+		zWriter, err := zlib.NewWriterLevel(bufWriter, 7)
+		if err != nil {
+			chunkWriter.CloseWithError(sdkerrors.Wrap(err, "zlib failure"))
+			return
+		}
+		defer func() {
+			if err := zWriter.Close(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+
+		protoWriter := protoio.NewDelimitedWriter(zWriter)
+		defer func() {
+			if err := protoWriter.Close(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+
+		// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
+		// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
+		// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
+		// are demarcated by new SnapshotStore items.
+		for _, store := range stores {
+			exporter, err := store.Export(int64(height))
+			if err != nil {
+				chunkWriter.CloseWithError(err)
+				return
+			}
+			defer exporter.Close()
+
+			err = protoWriter.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_Store{
+					Store: &types.SnapshotStoreItem{
+						Name: store.name,
+					},
+				},
+			})
+			if err != nil {
+				chunkWriter.CloseWithError(err)
+				return
+			}
+
+			for {
+				node, err := exporter.Next()
+				if err == iavltree.ExportDone {
+					break
+				} else if err != nil {
+					chunkWriter.CloseWithError(err)
+					return
+				}
+
+				err = protoWriter.WriteMsg(&types.SnapshotItem{
+					Item: &types.SnapshotItem_IAVL{
+						IAVL: &types.SnapshotIAVLItem{
+							Key:     node.Key,
+							Value:   node.Value,
+							Height:  int32(node.Height),
+							Version: node.Version,
+						},
+					},
+				})
+				if err != nil {
+					chunkWriter.CloseWithError(err)
+					return
+				}
+			}
+
+			exporter.Close()
+		}
+	}()
+
+	return ch, nil
+}
+
+// Restore implements snapshottypes.Snapshotter.
+func (rs *Store) Restore(
+	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
+) error {
+	if format != snapshottypes.CurrentFormat {
+		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+
+	if height == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
+	}
+
+	if height > math.MaxInt64 {
+		return sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata,
+			"snapshot height %v cannot exceed %v", height, math.MaxInt64)
+	}
+
+	// Signal readiness. Must be done before the readers below are set up, since the zlib
+	// reader reads from the stream on initialization, potentially causing deadlocks.
+	if ready != nil {
+		close(ready)
+	}
+
+	// Set up a restore stream pipeline
+	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
+	chunkReader := snapshots.NewChunkReader(chunks)
+	defer chunkReader.Close()
+
+	zReader, err := zlib.NewReader(chunkReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	defer zReader.Close()
+
+	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	defer protoReader.Close()
+
+	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
+	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
+	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
+	var importer *iavltree.Importer
+
+	for {
+		item := &types.SnapshotItem{}
+
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := item.Item.(type) {
+		case *types.SnapshotItem_Store:
+			if importer != nil {
+				err = importer.Commit()
+				if err != nil {
+					return sdkerrors.Wrap(err, "IAVL commit failed")
+				}
+
+				importer.Close()
+			}
+
+			store, ok := rs.getStoreByName(item.Store.Name).(*iavl.Store)
+			if !ok || store == nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
+			}
+
+			importer, err = store.Import(int64(height))
+			if err != nil {
+				return sdkerrors.Wrap(err, "import failed")
+			}
+			defer importer.Close()
+
+		case *types.SnapshotItem_IAVL:
+			if importer == nil {
+				return sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
+			}
+
+			if item.IAVL.Height > math.MaxInt8 {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+					item.IAVL.Height, math.MaxInt8)
+			}
+
+			node := &iavltree.ExportNode{
+				Key:     item.IAVL.Key,
+				Value:   item.IAVL.Value,
+				Height:  int8(item.IAVL.Height),
+				Version: item.IAVL.Version,
+			}
+
+			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+			if node.Key == nil {
+				node.Key = []byte{}
+			}
+
+			if node.Height == 0 && node.Value == nil {
+				node.Value = []byte{}
+			}
+
+			err := importer.Add(node)
+			if err != nil {
+				return sdkerrors.Wrap(err, "IAVL node import failed")
+			}
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", item)
+		}
+	}
+
+	if importer != nil {
+		err := importer.Commit()
+		if err != nil {
+			return sdkerrors.Wrap(err, "IAVL commit failed")
+		}
+
+		importer.Close()
+	}
+
+	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
+
+	return rs.LoadLatestVersion()
+}
+
+// buildCommitInfo builds the commitInfo of the mounted stores at the given
+// version without committing them.
+func (rs *Store) buildCommitInfo(version int64) commitInfo {
+	storeInfos := []storeInfo{}
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeTransient {
+			continue
+		}
+
+		storeInfos = append(storeInfos, storeInfo{
+			Name: key.Name(),
+			Core: storeCore{
+				CommitID: store.LastCommitID(),
+			},
+		})
+	}
+
+	return commitInfo{
+		Version:    version,
+		StoreInfos: storeInfos,
+	}
+}
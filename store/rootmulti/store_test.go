@@ -1,14 +1,18 @@
 package rootmulti
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/profilekv"
 	sdkmaps "github.com/cosmos/cosmos-sdk/store/rootmulti/internal/maps"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -270,6 +274,142 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	checkContains(t, ci.StoreInfos, []string{"store1", "restore2", "store3"})
 }
 
+func TestMultistoreLoadWithMigrations(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	s1 := store.getStoreByName("store1").(types.KVStore)
+	s1.Set([]byte("a/1"), []byte("a1"))
+	s1.Set([]byte("b/1"), []byte("b1"))
+	s1.Set([]byte("b/2"), []byte("b2"))
+
+	s2 := store.getStoreByName("store2").(types.KVStore)
+	s2.Set([]byte("old/1"), []byte("1"))
+	s2.Set([]byte("old/2"), []byte("2"))
+	s2.Set([]byte("other"), []byte("other"))
+
+	commitID := store.Commit()
+
+	newMigratedStore := func() *Store {
+		ms := newMultiStoreWithMounts(db, types.PruneNothing)
+		ms.MountStoreWithDB(types.NewKVStoreKey("store4"), types.StoreTypeIAVL, nil)
+
+		return ms
+	}
+
+	rewriteOld := func(key, value []byte) ([]byte, []byte, error) {
+		if bytes.Equal(key, []byte("old/2")) {
+			return nil, nil, nil
+		}
+
+		return append([]byte("new/"), key[len("old/"):]...), append(value, value...), nil
+	}
+
+	upgrades := &types.StoreUpgrades{
+		Added: []string{"store4"},
+		Split: []types.StoreSplit{{
+			OldKey:   "store1",
+			NewKey:   "store4",
+			Prefixes: [][]byte{[]byte("b/")},
+		}},
+		Rewrites: []types.KeyRewrite{{
+			StoreKey: "store2",
+			Prefix:   []byte("old/"),
+			Rewrite:  rewriteOld,
+		}},
+	}
+
+	// failing migrations are caught by the dry run, and leave the store untouched
+	failing := []*types.StoreUpgrades{
+		{Added: []string{"store1"}},
+		{Added: []string{"store5"}},
+		{Split: []types.StoreSplit{{OldKey: "store1", NewKey: "store5", Prefixes: [][]byte{[]byte("b/")}}}},
+		{Split: []types.StoreSplit{{OldKey: "store1", NewKey: "store2"}}},
+		{Rewrites: []types.KeyRewrite{{
+			StoreKey: "store2",
+			Prefix:   []byte("old/"),
+			Rewrite: func(key, value []byte) ([]byte, []byte, error) {
+				return []byte("other"), value, nil
+			},
+		}}},
+		{Rewrites: []types.KeyRewrite{{
+			StoreKey: "store2",
+			Prefix:   []byte("old/"),
+			Rewrite: func(key, value []byte) ([]byte, []byte, error) {
+				return nil, nil, fmt.Errorf("invalid key %s", key)
+			},
+		}}},
+		{Rewrites: []types.KeyRewrite{{
+			StoreKey: "store2",
+			Rewrite: func(key, value []byte) ([]byte, []byte, error) {
+				panic("rewrite panic")
+			},
+		}}},
+	}
+
+	for _, upgrades := range failing {
+		ms := newMigratedStore()
+		require.Error(t, ms.DryRunUpgrade(1, upgrades))
+		require.Error(t, ms.LoadLatestVersionAndUpgrade(upgrades))
+	}
+
+	// the dry run writes nothing to the database
+	dbPairs := func() []types.KVPair {
+		var pairs []types.KVPair
+
+		itr, err := db.Iterator(nil, nil)
+		require.NoError(t, err)
+		for ; itr.Valid(); itr.Next() {
+			pairs = append(pairs, types.KVPair{Key: itr.Key(), Value: itr.Value()})
+		}
+		itr.Close()
+
+		return pairs
+	}
+	before := dbPairs()
+
+	ms := newMigratedStore()
+	require.NoError(t, ms.DryRunUpgrade(1, upgrades))
+	require.Equal(t, before, dbPairs())
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, commitID, ms.LastCommitID())
+	require.Equal(t, []byte("b1"), ms.getStoreByName("store1").(types.KVStore).Get([]byte("b/1")))
+
+	// apply the migrations and commit them
+	ms = newMigratedStore()
+	require.NoError(t, ms.LoadLatestVersionAndUpgrade(upgrades))
+	require.Equal(t, int64(2), ms.Commit().Version)
+
+	ms = newMigratedStore()
+	require.NoError(t, ms.LoadLatestVersion())
+
+	kvPairs := func(name string) (pairs []types.KVPair) {
+		itr := ms.getStoreByName(name).(types.KVStore).Iterator(nil, nil)
+		defer itr.Close()
+
+		for ; itr.Valid(); itr.Next() {
+			pairs = append(pairs, types.KVPair{Key: itr.Key(), Value: itr.Value()})
+		}
+
+		return pairs
+	}
+
+	require.Equal(t, []types.KVPair{{Key: []byte("a/1"), Value: []byte("a1")}}, kvPairs("store1"))
+	require.Equal(t, []types.KVPair{
+		{Key: []byte("new/1"), Value: []byte("11")},
+		{Key: []byte("other"), Value: []byte("other")},
+	}, kvPairs("store2"))
+	require.Equal(t, []types.KVPair{
+		{Key: []byte("b/1"), Value: []byte("b1")},
+		{Key: []byte("b/2"), Value: []byte("b2")},
+	}, kvPairs("store4"))
+
+	ci, err := getCommitInfo(db, 2)
+	require.NoError(t, err)
+	checkContains(t, ci.StoreInfos, []string{"store1", "store2", "store3", "store4"})
+}
+
 func TestParsePath(t *testing.T) {
 	_, _, err := parsePath("foo")
 	require.Error(t, err)
@@ -484,6 +624,43 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_PruningDeferred(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneEverything)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// height 3 is being read until height 15 is committed
+	var seen [][]int64
+	ms.SetBeforePrune(func(heights []int64) []int64 {
+		seen = append(seen, heights)
+		if ms.LastCommitID().Version < 14 {
+			return []int64{3}
+		}
+
+		return nil
+	})
+
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	_, err := ms.CacheMultiStoreWithVersion(3)
+	require.NoError(t, err)
+	_, err = ms.CacheMultiStoreWithVersion(2)
+	require.Error(t, err)
+
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	require.Equal(t, [][]int64{{1, 2, 3, 4, 5, 6, 7, 8, 9}, {3, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}}, seen)
+
+	for v := int64(1); v < 20; v++ {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+}
+
 func TestMultiStore_PruningRestart(t *testing.T) {
 	db := dbm.NewMemDB()
 	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 3, 11))
@@ -518,6 +695,89 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestPruneVersions(t *testing.T) {
+	testCases := []struct {
+		name    string
+		po      types.PruningOptions
+		deleted []int64
+		saved   []int64
+	}{
+		{"prune everything", types.PruneEverything, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, []int64{10}},
+		{"prune some", types.NewPruningOptions(2, 3, 0), []int64{1, 2, 4, 5, 7}, []int64{3, 6, 8, 9, 10}},
+		{"keep recent", types.NewPruningOptions(20, 0, 0), []int64{}, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			ms := newMultiStoreWithMounts(db, types.PruneNothing)
+			require.NoError(t, ms.LoadLatestVersion())
+
+			for i := int64(0); i < 10; i++ {
+				ms.Commit()
+			}
+
+			pruned, err := PruneVersions(db, tc.po)
+			require.NoError(t, err)
+			require.Equal(t, tc.deleted, pruned)
+
+			ms = newMultiStoreWithMounts(db, types.PruneNothing)
+			require.NoError(t, ms.LoadLatestVersion())
+			require.Equal(t, int64(10), ms.LastCommitID().Version)
+
+			for _, v := range tc.saved {
+				_, err := ms.CacheMultiStoreWithVersion(v)
+				require.NoError(t, err, "expected no error when loading height: %d", v)
+			}
+
+			for _, v := range tc.deleted {
+				_, err := ms.CacheMultiStoreWithVersion(v)
+				require.Error(t, err, "expected error when loading height: %d", v)
+			}
+		})
+	}
+}
+
+func TestPruneVersionsPendingHeights(t *testing.T) {
+	db := dbm.NewMemDB()
+
+	pruned, err := PruneVersions(db, types.PruneEverything)
+	require.NoError(t, err)
+	require.Empty(t, pruned)
+
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 3, 11))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	_, err = PruneVersions(db, types.NewPruningOptions(4, 0, 0))
+	require.NoError(t, err)
+
+	// the pending heights which were pruned are no longer pending
+	ph, err := getPruningHeights(db)
+	require.NoError(t, err)
+	require.Equal(t, []int64{7}, ph)
+
+	// and the store keeps on pruning at commit time
+	ms = newMultiStoreWithMounts(db, types.NewPruningOptions(2, 3, 11))
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.Commit()
+
+	for _, v := range []int64{6, 9, 10, 11} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+	}
+
+	for _, v := range []int64{1, 5, 7, 8} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+}
+
 //-----------------------------------------------------------------------
 // utils
 
@@ -596,3 +856,405 @@ func hashStores(stores map[types.StoreKey]types.CommitKVStore) []byte {
 	}
 	return sdkmaps.SimpleHashFromMap(m)
 }
+
+func TestMultiStore_SnapshotRestore(t *testing.T) {
+	source := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, source.LoadLatestVersion())
+
+	for i := 1; i <= 3; i++ {
+		for _, name := range []string{"store1", "store2", "store3"} {
+			store := source.getStoreByName(name).(types.KVStore)
+			for j := 0; j < 100; j++ {
+				store.Set([]byte(fmt.Sprintf("key%03d", j)), []byte(fmt.Sprintf("%s:%d:%d", name, i, j)))
+			}
+			store.Delete([]byte(fmt.Sprintf("key%03d", i)))
+		}
+		source.Commit()
+	}
+
+	// Snapshotting unknown formats or future heights should error
+	_, err := source.Snapshot(2, 0)
+	require.Error(t, err)
+	_, err = source.Snapshot(4, snapshottypes.CurrentFormat)
+	require.Error(t, err)
+
+	chunks, err := source.Snapshot(2, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+
+	target := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, target.LoadLatestVersion())
+
+	err = target.Restore(2, snapshottypes.CurrentFormat, chunks, nil)
+	require.NoError(t, err)
+
+	sourceInfo, err := getCommitInfo(source.db, 2)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(2), target.LastCommitID().Version)
+	require.Equal(t, sourceInfo.CommitID().Hash, target.LastCommitID().Hash)
+
+	for _, name := range []string{"store1", "store2", "store3"} {
+		sourceStore, err := source.getStoreByName(name).(*iavl.Store).GetImmutable(2)
+		require.NoError(t, err)
+		targetStore := target.getStoreByName(name).(types.KVStore)
+
+		iter := sourceStore.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			require.Equal(t, iter.Value(), targetStore.Get(iter.Key()))
+		}
+		iter.Close()
+		require.Nil(t, targetStore.Get([]byte("key002")))
+	}
+}
+
+func TestMultiStore_ExportImportVersion(t *testing.T) {
+	source := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, source.LoadLatestVersion())
+
+	for i := 1; i <= 3; i++ {
+		for _, name := range []string{"store1", "store2"} {
+			store := source.getStoreByName(name).(types.KVStore)
+			for j := 0; j < 100*i; j++ {
+				store.Set([]byte(fmt.Sprintf("key%03d", j)), []byte(fmt.Sprintf("%s:%d:%d", name, i, j)))
+			}
+			store.Delete([]byte(fmt.Sprintf("key%03d", i)))
+		}
+		source.Commit()
+	}
+
+	// exporting unsaved or future versions should error
+	require.Error(t, source.ExportVersion(0, &bytes.Buffer{}))
+	require.Error(t, source.ExportVersion(4, &bytes.Buffer{}))
+
+	export := &bytes.Buffer{}
+	require.NoError(t, source.ExportVersion(2, export))
+
+	target := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, target.LoadLatestVersion())
+
+	version, err := target.ImportVersion(bytes.NewReader(export.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), version)
+
+	// the imported stores have the exported hashes
+	sourceInfo, err := getCommitInfo(source.db, 2)
+	require.NoError(t, err)
+	require.Equal(t, sourceInfo.CommitID(), target.LastCommitID())
+
+	targetInfo, err := getCommitInfo(target.db, 2)
+	require.NoError(t, err)
+	require.ElementsMatch(t, sourceInfo.StoreInfos, targetInfo.StoreInfos)
+
+	for _, name := range []string{"store1", "store2", "store3"} {
+		sourceStore, err := source.getStoreByName(name).(*iavl.Store).GetImmutable(2)
+		require.NoError(t, err)
+		targetStore := target.getStoreByName(name).(types.KVStore)
+
+		var sourcePairs, targetPairs []types.KVPair
+
+		iter := sourceStore.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			sourcePairs = append(sourcePairs, types.KVPair{Key: iter.Key(), Value: iter.Value()})
+		}
+		iter.Close()
+
+		iter = targetStore.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			targetPairs = append(targetPairs, types.KVPair{Key: iter.Key(), Value: iter.Value()})
+		}
+		iter.Close()
+
+		require.Equal(t, sourcePairs, targetPairs, name)
+	}
+
+	// the imported stores can be committed on
+	target.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte("value"))
+	require.Equal(t, int64(3), target.Commit().Version)
+
+	// reloading the imported stores should succeed
+	reloaded := newMultiStoreWithMounts(target.db, types.PruneNothing)
+	require.NoError(t, reloaded.LoadVersion(2))
+	require.Equal(t, []byte("store1:2:199"), reloaded.getStoreByName("store1").(types.KVStore).Get([]byte("key199")))
+
+	// importing into a store which has a version should error
+	_, err = target.ImportVersion(bytes.NewReader(export.Bytes()))
+	require.Error(t, err)
+
+	// importing a corrupted export should error
+	corrupted := append([]byte{}, export.Bytes()...)
+	i := bytes.Index(corrupted, []byte("store1:2:42"))
+	require.True(t, i > 0)
+	corrupted[i] = 'X'
+
+	target = newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, target.LoadLatestVersion())
+
+	_, err = target.ImportVersion(bytes.NewReader(corrupted))
+	require.Error(t, err)
+
+	// importing a truncated export should error
+	target = newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, target.LoadLatestVersion())
+
+	_, err = target.ImportVersion(bytes.NewReader(export.Bytes()[:export.Len()-1]))
+	require.Error(t, err)
+
+	// importing into stores mounted differently should error, as the app hash
+	// differs
+	target = newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	target.MountStoreWithDB(types.NewKVStoreKey("store4"), types.StoreTypeIAVL, nil)
+	require.NoError(t, target.LoadLatestVersion())
+
+	_, err = target.ImportVersion(bytes.NewReader(export.Bytes()))
+	require.Error(t, err)
+
+	// importing stores which are not mounted should error
+	target = NewStore(dbm.NewMemDB())
+	target.MountStoreWithDB(types.NewKVStoreKey("store2"), types.StoreTypeIAVL, nil)
+	require.NoError(t, target.LoadLatestVersion())
+
+	_, err = target.ImportVersion(bytes.NewReader(export.Bytes()))
+	require.Error(t, err)
+}
+
+func TestMultiStore_Profile(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	profiler := profilekv.NewProfiler(1)
+	multi.SetProfiler(profiler)
+
+	cacheMulti := multi.CacheMultiStore()
+	store1 := cacheMulti.GetKVStore(multi.keysByName["store1"])
+	store1.Set([]byte("a1"), []byte("value"))
+	store1.Set([]byte("b1"), []byte("value"))
+	cacheMulti.Write()
+	multi.Commit()
+
+	multi.getStoreByName("store1").(types.KVStore).Set([]byte("a2"), []byte("value"))
+	multi.Commit()
+
+	report := profiler.Report()
+	require.Len(t, report.Stores, 1)
+	require.Equal(t, uint64(2), report.Stores[0].Total.Writes)
+
+	// profiling a version records the pairs of its stores
+	profiler = profilekv.NewProfiler(1)
+	version, err := ProfileVersion(db, 1, profiler)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+
+	report = profiler.Report()
+	require.Len(t, report.Stores, 1)
+	require.Equal(t, "store1", report.Stores[0].StoreKey)
+	require.Equal(t, uint64(2), report.Stores[0].Total.Keys)
+
+	profiler = profilekv.NewProfiler(1)
+	version, err = ProfileVersion(db, 0, profiler)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), version)
+	require.Equal(t, uint64(3), profiler.Report().Stores[0].Total.Keys)
+
+	_, err = ProfileVersion(db, 3, profiler)
+	require.Error(t, err)
+}
+
+func TestVerifyVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	multi.MountStoreWithDB(types.NewKVStoreKey("smt"), types.StoreTypeSMT, nil)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	for i := 0; i < 10; i++ {
+		multi.getStoreByName("store1").(types.KVStore).Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+	}
+	multi.getStoreByName("smt").(types.KVStore).Set([]byte("key"), []byte("value"))
+	commitID := multi.Commit()
+
+	res, err := VerifyVersion(db, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Version)
+	require.Equal(t, commitID.Hash, res.AppHash)
+	require.False(t, res.Corrupted())
+	require.Len(t, res.Stores, 4)
+
+	for _, store := range res.Stores {
+		switch store.Name {
+		case "smt":
+			require.True(t, store.Skipped)
+		case "store1":
+			require.Equal(t, int64(19), store.Nodes)
+		default:
+			require.False(t, store.Skipped)
+			require.Zero(t, store.Nodes)
+		}
+	}
+
+	// corrupt a node of store1
+	prefix := []byte("s/k:store1/n")
+	itr, err := dbm.IteratePrefix(db, prefix)
+	require.NoError(t, err)
+	key := append([]byte{}, itr.Key()...)
+	itr.Close()
+	require.NoError(t, db.Set(key, []byte{0xff}))
+
+	res, err = VerifyVersion(db, 1)
+	require.NoError(t, err)
+	require.True(t, res.Corrupted())
+
+	for _, store := range res.Stores {
+		if store.Name == "store1" {
+			require.Len(t, store.Corruptions, 1)
+			require.Equal(t, key[len(prefix):], store.Corruptions[0].Hash)
+		} else {
+			require.Empty(t, store.Corruptions)
+		}
+	}
+
+	_, err = VerifyVersion(db, 2)
+	require.Error(t, err)
+}
+
+type recordingListener struct {
+	pairs []types.StoreKVPair
+}
+
+func (l *recordingListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.pairs = append(l.pairs, types.StoreKVPair{StoreKey: storeKey.Name(), Delete: delete, Key: key, Value: value})
+	return nil
+}
+
+func TestMultiStore_SMTStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	smtKey := types.NewKVStoreKey("smt")
+
+	newStore := func() *Store {
+		ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 3, 1))
+		ms.MountStoreWithDB(smtKey, types.StoreTypeSMT, nil)
+		require.NoError(t, ms.LoadLatestVersion())
+
+		return ms
+	}
+
+	ms := newStore()
+
+	var cids []types.CommitID
+	for i := 1; i <= 10; i++ {
+		ms.GetKVStore(smtKey).Set([]byte("key"), []byte(fmt.Sprintf("value%d", i)))
+		cids = append(cids, ms.Commit())
+	}
+
+	// the versions of the SMT store are pruned with the IAVL stores
+	for _, v := range []int64{1, 2, 4, 5, 7} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+
+	for _, v := range []int64{3, 6, 8, 9, 10} {
+		cms, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", v)), cms.GetKVStore(smtKey).Get([]byte("key")))
+	}
+
+	// the SMT store is reloaded at the latest version
+	ms = newStore()
+	require.Equal(t, cids[9], ms.LastCommitID())
+	require.Equal(t, []byte("value10"), ms.GetKVStore(smtKey).Get([]byte("key")))
+
+	// SMT stores cannot be exported
+	require.Error(t, ms.ExportVersion(10, ioutil.Discard))
+}
+
+func TestMultiStore_SMTStoreOffline(t *testing.T) {
+	db := dbm.NewMemDB()
+	smtKey := types.NewKVStoreKey("smt")
+
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	ms.MountStoreWithDB(smtKey, types.StoreTypeSMT, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 1; i <= 10; i++ {
+		ms.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte(fmt.Sprintf("value%d", i)))
+		ms.GetKVStore(smtKey).Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+		ms.Commit()
+	}
+
+	// SMT stores are skipped when profiling a version
+	profiler := profilekv.NewProfiler(1)
+	_, err := ProfileVersion(db, 0, profiler)
+	require.NoError(t, err)
+
+	report := profiler.Report()
+	require.Len(t, report.Stores, 1)
+	require.Equal(t, "store1", report.Stores[0].StoreKey)
+
+	// and when pruning versions
+	pruned, err := PruneVersions(db, types.PruneEverything)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, pruned)
+
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	ms.MountStoreWithDB(smtKey, types.StoreTypeSMT, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, int64(10), ms.LastCommitID().Version)
+	require.Equal(t, []byte("value"), ms.GetKVStore(smtKey).Get([]byte("key10")))
+}
+
+func TestMultiStore_CacheListening(t *testing.T) {
+	multi := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	key1, key2 := multi.keysByName["store1"], multi.keysByName["store2"]
+	listener := &recordingListener{}
+
+	cms := multi.CacheMultiStore()
+	cms.AddListeners(key1, []types.WriteListener{listener})
+	require.True(t, cms.ListeningEnabled(key1))
+	require.False(t, cms.ListeningEnabled(key2))
+	require.False(t, multi.ListeningEnabled(key1))
+
+	// writes to the listened store are passed on, others are not
+	cms.GetKVStore(key1).Set([]byte("a"), []byte("1"))
+	cms.GetKVStore(key2).Set([]byte("b"), []byte("2"))
+	require.Equal(t, []types.StoreKVPair{{StoreKey: "store1", Key: []byte("a"), Value: []byte("1")}}, listener.pairs)
+
+	// writes to a nested cache are passed on only once the cache is written
+	listener.pairs = nil
+	discarded := cms.CacheMultiStore()
+	discarded.GetKVStore(key1).Set([]byte("c"), []byte("3"))
+
+	nested := cms.CacheMultiStore()
+	require.False(t, nested.ListeningEnabled(key1))
+	nested.GetKVStore(key1).Delete([]byte("a"))
+	require.Empty(t, listener.pairs)
+
+	nested.Write()
+	require.Equal(t, []types.StoreKVPair{{StoreKey: "store1", Delete: true, Key: []byte("a")}}, listener.pairs)
+
+	// writing the cache to the root store does not pass the writes on again
+	listener.pairs = nil
+	cms.Write()
+	require.Empty(t, listener.pairs)
+}
+
+func TestMultiStore_RootListening(t *testing.T) {
+	multi := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	key1 := multi.keysByName["store1"]
+	listener := &recordingListener{}
+
+	multi.AddListeners(key1, []types.WriteListener{listener})
+	require.True(t, multi.ListeningEnabled(key1))
+
+	multi.GetKVStore(key1).Set([]byte("a"), []byte("1"))
+	require.Len(t, listener.pairs, 1)
+
+	// listeners are carried over to cache-wrapped stores
+	cms := multi.CacheMultiStore()
+	require.True(t, cms.ListeningEnabled(key1))
+	cms.GetKVStore(key1).Set([]byte("b"), []byte("2"))
+	require.Len(t, listener.pairs, 2)
+	require.Equal(t, []byte("b"), listener.pairs[1].Key)
+}
@@ -0,0 +1,282 @@
+package rootmulti
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// DryRunUpgrade loads the given version with the given upgrades into a branch
+// of the Store and commits it, returning an error if the upgrades fail to apply.
+// The branch reads the database of the Store and holds what is written to it in
+// memory, so that only the data the upgrades write is held in memory and the
+// Store itself is left untouched.
+func (rs *Store) DryRunUpgrade(ver int64, upgrades *types.StoreUpgrades) (err error) {
+	// key rewrite functions may panic, as may committing
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	branch := NewStore(newBranchDB(rs.db))
+
+	for key, params := range rs.storesParams {
+		if params.db != nil {
+			params.db = newBranchDB(params.db)
+		}
+
+		branch.MountStoreWithDB(key, params.typ, params.db)
+	}
+
+	if err := branch.loadVersion(ver, upgrades, true); err != nil {
+		return err
+	}
+
+	branch.Commit()
+
+	return nil
+}
+
+// branchDB is a database branched off another one: reads fall through to the
+// parent database, while writes are held in memory and never written to it.
+type branchDB struct {
+	cache *cachekv.Store
+}
+
+var _ dbm.DB = (*branchDB)(nil)
+
+func newBranchDB(parent dbm.DB) *branchDB {
+	return &branchDB{cache: cachekv.NewStore(dbadapter.Store{DB: parent})}
+}
+
+// Get implements dbm.DB.
+func (db *branchDB) Get(key []byte) ([]byte, error) {
+	return db.cache.Get(key), nil
+}
+
+// Has implements dbm.DB.
+func (db *branchDB) Has(key []byte) (bool, error) {
+	return db.cache.Has(key), nil
+}
+
+// Set implements dbm.DB.
+func (db *branchDB) Set(key, value []byte) error {
+	db.cache.Set(key, value)
+	return nil
+}
+
+// SetSync implements dbm.DB.
+func (db *branchDB) SetSync(key, value []byte) error {
+	return db.Set(key, value)
+}
+
+// Delete implements dbm.DB.
+func (db *branchDB) Delete(key []byte) error {
+	db.cache.Delete(key)
+	return nil
+}
+
+// DeleteSync implements dbm.DB.
+func (db *branchDB) DeleteSync(key []byte) error {
+	return db.Delete(key)
+}
+
+// Iterator implements dbm.DB.
+func (db *branchDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return db.cache.Iterator(start, end), nil
+}
+
+// ReverseIterator implements dbm.DB.
+func (db *branchDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return db.cache.ReverseIterator(start, end), nil
+}
+
+// Close implements dbm.DB. The parent database is left open.
+func (db *branchDB) Close() error {
+	return nil
+}
+
+// NewBatch implements dbm.DB.
+func (db *branchDB) NewBatch() dbm.Batch {
+	return &branchBatch{db: db}
+}
+
+// Print implements dbm.DB.
+func (db *branchDB) Print() error {
+	return nil
+}
+
+// Stats implements dbm.DB.
+func (db *branchDB) Stats() map[string]string {
+	return map[string]string{"database.type": "branchDB"}
+}
+
+// branchBatch is a batch of writes to a branchDB, applied when written.
+type branchBatch struct {
+	db  *branchDB
+	ops []types.KVPair
+}
+
+// Set implements dbm.Batch.
+func (b *branchBatch) Set(key, value []byte) {
+	b.ops = append(b.ops, types.KVPair{Key: key, Value: value})
+}
+
+// Delete implements dbm.Batch.
+func (b *branchBatch) Delete(key []byte) {
+	b.ops = append(b.ops, types.KVPair{Key: key})
+}
+
+// Write implements dbm.Batch.
+func (b *branchBatch) Write() error {
+	for _, op := range b.ops {
+		if op.Value == nil {
+			b.db.cache.Delete(op.Key)
+		} else {
+			b.db.cache.Set(op.Key, op.Value)
+		}
+	}
+
+	b.ops = nil
+
+	return nil
+}
+
+// WriteSync implements dbm.Batch.
+func (b *branchBatch) WriteSync() error {
+	return b.Write()
+}
+
+// Close implements dbm.Batch.
+func (b *branchBatch) Close() {
+	b.ops = nil
+}
+
+// migrateStores checks that the added stores are mounted, then applies the
+// splits and key rewrites of the given upgrades to the loaded stores.
+func migrateStores(stores map[types.StoreKey]types.CommitKVStore, upgrades *types.StoreUpgrades) error {
+	if upgrades == nil {
+		return nil
+	}
+
+	byName := make(map[string]types.KVStore, len(stores))
+	for key, store := range stores {
+		byName[key.Name()] = store.(types.KVStore)
+	}
+
+	for _, name := range upgrades.Added {
+		if byName[name] == nil {
+			return fmt.Errorf("added store %s is not mounted", name)
+		}
+	}
+
+	for _, split := range upgrades.Split {
+		from, to := byName[split.OldKey], byName[split.NewKey]
+
+		switch {
+		case from == nil:
+			return fmt.Errorf("split store %s is not mounted", split.OldKey)
+		case to == nil:
+			return fmt.Errorf("store %s split from %s is not mounted", split.NewKey, split.OldKey)
+		case split.OldKey == split.NewKey:
+			return fmt.Errorf("cannot split store %s into itself", split.OldKey)
+		case len(split.Prefixes) == 0:
+			return fmt.Errorf("no prefix to split store %s by", split.OldKey)
+		}
+
+		for _, prefix := range split.Prefixes {
+			if err := moveKVStorePrefix(from, to, prefix); err != nil {
+				return errors.Wrapf(err, "failed to split store %s -> %s", split.OldKey, split.NewKey)
+			}
+		}
+	}
+
+	for _, rewrite := range upgrades.Rewrites {
+		kv := byName[rewrite.StoreKey]
+
+		switch {
+		case kv == nil:
+			return fmt.Errorf("rewritten store %s is not mounted", rewrite.StoreKey)
+		case rewrite.Rewrite == nil:
+			return fmt.Errorf("no rewrite function for store %s", rewrite.StoreKey)
+		}
+
+		if err := rewriteKVStorePrefix(kv, rewrite.Prefix, rewrite.Rewrite); err != nil {
+			return errors.Wrapf(err, "failed to rewrite keys of store %s under prefix %X", rewrite.StoreKey, rewrite.Prefix)
+		}
+	}
+
+	return nil
+}
+
+// prefixPairs returns all the key-value pairs of a store under a prefix.
+func prefixPairs(kv types.KVStore, prefix []byte) []types.KVPair {
+	var pairs []types.KVPair
+
+	itr := types.KVStorePrefixIterator(kv, prefix)
+	for ; itr.Valid(); itr.Next() {
+		pairs = append(pairs, types.KVPair{Key: itr.Key(), Value: itr.Value()})
+	}
+	itr.Close()
+
+	return pairs
+}
+
+// moveKVStorePrefix moves all the data of a store under a prefix to another,
+// which must not have any of its keys.
+func moveKVStorePrefix(from, to types.KVStore, prefix []byte) error {
+	pairs := prefixPairs(from, prefix)
+
+	for _, pair := range pairs {
+		if to.Has(pair.Key) {
+			return fmt.Errorf("key %X already exists", pair.Key)
+		}
+
+		to.Set(pair.Key, pair.Value)
+		from.Delete(pair.Key)
+	}
+
+	return nil
+}
+
+// rewriteKVStorePrefix replaces all the key-value pairs of a store under a
+// prefix by the pairs returned by the given function.
+func rewriteKVStorePrefix(kv types.KVStore, prefix []byte, rewrite types.KeyRewriter) error {
+	pairs := prefixPairs(kv, prefix)
+
+	for _, pair := range pairs {
+		kv.Delete(pair.Key)
+	}
+
+	written := make(map[string]bool, len(pairs))
+
+	for _, pair := range pairs {
+		key, value, err := rewrite(pair.Key, pair.Value)
+		if err != nil {
+			return errors.Wrapf(err, "failed to rewrite key %X", pair.Key)
+		}
+
+		if value == nil {
+			continue
+		}
+
+		if len(key) == 0 {
+			return fmt.Errorf("key %X rewritten to an empty key", pair.Key)
+		}
+
+		if written[string(key)] || kv.Has(key) {
+			return fmt.Errorf("key %X rewritten to existing key %X", pair.Key, key)
+		}
+
+		written[string(key)] = true
+		kv.Set(key, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package smt
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// step is a step of the walk from the root of a tree to a leaf, going down the
+// given side of an inner node.
+type step struct {
+	node *node
+	side int
+}
+
+// walk walks down the tree under the given root along the given path, and
+// returns the leaf it ends at, whose path shares the longest prefix with the
+// given path, and the steps taken to reach it.
+func (t *tree) walk(root *node, path []byte) (*node, []step) {
+	var steps []step
+
+	n := root
+	for !n.leaf {
+		side := bitAt(path, n.bit)
+		steps = append(steps, step{node: n, side: side})
+		n = t.child(n, side)
+	}
+
+	return n, steps
+}
+
+// existenceProof returns the ics23 existence proof of a leaf under the given
+// root.
+func (t *tree) existenceProof(root *node, leaf *node) *ics23.ExistenceProof {
+	_, steps := t.walk(root, leaf.path)
+
+	proof := &ics23.ExistenceProof{
+		Key:   leaf.path,
+		Value: leaf.value,
+		Leaf:  types.SmtSpec.LeafSpec,
+		Path:  make([]*ics23.InnerOp, 0, len(steps)),
+	}
+
+	// the path of the proof goes from the leaf up to the root
+	for i := len(steps) - 1; i >= 0; i-- {
+		sibling := t.hashNode(t.child(steps[i].node, 1-steps[i].side))
+
+		op := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+		if steps[i].side == 0 {
+			op.Prefix, op.Suffix = innerPrefix, sibling
+		} else {
+			op.Prefix = append(append([]byte{}, innerPrefix...), sibling...)
+		}
+
+		proof.Path = append(proof.Path, op)
+	}
+
+	return proof
+}
+
+// nonExistenceProof returns the ics23 non-existence proof of a path under the
+// given root, proving the leaves immediately left and right of it.
+func (t *tree) nonExistenceProof(root *node, path []byte) *ics23.NonExistenceProof {
+	closest, steps := t.walk(root, path)
+	crit := critBit(path, closest.path)
+
+	// the path would be inserted above the first node of the walk splitting at
+	// a later bit than the one it differs from its closest leaf at, whose
+	// subtree is entirely left or right of it
+	i := 0
+	for i < len(steps) && steps[i].node.bit < crit {
+		i++
+	}
+
+	subtree := closest
+	if i < len(steps) {
+		subtree = steps[i].node
+	}
+
+	proof := &ics23.NonExistenceProof{Key: path}
+
+	// side is the side of the path relative to the subtree, which is its
+	// neighbor on the other side; its neighbor on the same side is in the
+	// sibling subtree of the deepest node above it the walk went the other
+	// way at
+	side := bitAt(path, crit)
+	neighbors := [2]*node{}
+	neighbors[1-side] = t.edgeLeaf(subtree, side)
+
+	for j := i - 1; j >= 0; j-- {
+		if steps[j].side != side {
+			neighbors[side] = t.edgeLeaf(t.child(steps[j].node, side), 1-side)
+			break
+		}
+	}
+
+	if neighbors[0] != nil {
+		proof.Left = t.existenceProof(root, neighbors[0])
+	}
+
+	if neighbors[1] != nil {
+		proof.Right = t.existenceProof(root, neighbors[1])
+	}
+
+	return proof
+}
+
+// edgeLeaf returns the leftmost leaf of a subtree if side is 0, and its
+// rightmost leaf otherwise.
+func (t *tree) edgeLeaf(n *node, side int) *node {
+	for !n.leaf {
+		n = t.child(n, side)
+	}
+
+	return n
+}
+
+// getProof returns the merkle.Proof of the existence or absence of a key under
+// the given root. It panics if the tree is empty, as there is no root to prove
+// against.
+func (t *tree) getProof(root *node, key []byte) *merkle.Proof {
+	if root == nil {
+		panic("cannot prove a key of an empty tree")
+	}
+
+	path := sha256.Sum256(key)
+
+	var proof *ics23.CommitmentProof
+
+	if leaf, _ := t.walk(root, path[:]); critBit(leaf.path, path[:]) < 0 {
+		proof = &ics23.CommitmentProof{
+			Proof: &ics23.CommitmentProof_Exist{Exist: t.existenceProof(root, leaf)},
+		}
+	} else {
+		proof = &ics23.CommitmentProof{
+			Proof: &ics23.CommitmentProof_Nonexist{Nonexist: t.nonExistenceProof(root, path[:])},
+		}
+	}
+
+	if _, err := proof.Calculate(); err != nil {
+		panic(fmt.Sprintf("failed to calculate proof: %s", err))
+	}
+
+	op := types.NewSmtCommitmentOp(key, proof)
+
+	return &merkle.Proof{Ops: []merkle.ProofOp{op.ProofOp()}}
+}
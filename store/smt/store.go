@@ -0,0 +1,462 @@
+package smt
+
+import (
+	"encoding/binary"
+	"io"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ types.KVStore       = (*Store)(nil)
+	_ types.CommitStore   = (*Store)(nil)
+	_ types.CommitKVStore = (*Store)(nil)
+	_ types.Queryable     = (*Store)(nil)
+)
+
+var (
+	indexPrefix  = []byte("k") // k<key> -> value of the latest saved version
+	indexVersion = []byte("m") // m -> version of the index
+	changePrefix = []byte("c") // c<version><key> -> nothing, for the keys written at a version
+)
+
+// Store implements types.KVStore and CommitKVStore on a sparse Merkle tree.
+//
+// The keys of the latest saved version are also indexed by key, so that they can
+// be read and iterated without going through the tree, whose leaves are ordered
+// by the hashes of their keys. The keys written at each version are recorded as
+// well, so that earlier versions can be iterated over through the index and the
+// keys written since. Both are written in the same batch as the tree when
+// committing.
+type Store struct {
+	db   dbm.DB
+	tree *tree
+	hash []byte
+
+	// index holds the working state of a mutable store, and dirty the keys
+	// written since the last commit, with a nil value for deleted keys
+	index *cachekv.Store
+	dirty map[string][]byte
+
+	// root is the root of the version of an immutable store
+	immutable bool
+	version   int64
+	root      *node
+}
+
+// IsStore returns whether the given database holds an SMT store, which is
+// recognized by the version of its key index, as SMT and IAVL stores save their
+// nodes under the same prefixes.
+func IsStore(db dbm.DB) (bool, error) {
+	return db.Has(indexVersion)
+}
+
+// LoadStore returns an SMT Store as a CommitKVStore, loading the store's
+// version (id) from the provided DB. An error is returned if the version fails
+// to load.
+func LoadStore(db dbm.DB, id types.CommitID) (types.CommitKVStore, error) {
+	tree, err := loadTree(db, id.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &Store{db: db, tree: tree, hash: tree.hash()}
+
+	bz, err := db.Get(indexVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// the index is rebuilt if it is not of the loaded version, e.g. when
+	// loading an earlier version than the latest one
+	if len(bz) != 8 || int64(binary.BigEndian.Uint64(bz)) != id.Version {
+		if err := st.rebuildIndex(); err != nil {
+			return nil, err
+		}
+	}
+
+	st.resetIndex()
+
+	return st, nil
+}
+
+// rebuildIndex rewrites the index with the keys of the loaded version.
+func (st *Store) rebuildIndex() error {
+	index := dbm.NewPrefixDB(st.db, indexPrefix)
+
+	it, err := index.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, append([]byte{}, it.Key()...))
+	}
+
+	it.Close()
+
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		batch.Delete(indexKey(key))
+	}
+
+	st.tree.leaves(st.tree.root, func(n *node) {
+		batch.Set(indexKey(n.key), n.value)
+	})
+
+	batch.Set(indexVersion, versionBytes(st.tree.version))
+
+	return batch.Write()
+}
+
+func (st *Store) resetIndex() {
+	st.index = cachekv.NewStore(dbadapter.Store{DB: dbm.NewPrefixDB(st.db, indexPrefix)})
+	st.dirty = make(map[string][]byte)
+}
+
+func indexKey(key []byte) []byte {
+	return append(append([]byte{}, indexPrefix...), key...)
+}
+
+// changesKey returns the prefix of the keys written at the given version.
+func changesKey(version int64) []byte {
+	return append(append([]byte{}, changePrefix...), versionBytes(version)...)
+}
+
+func versionBytes(version int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+
+	return bz
+}
+
+// GetImmutable returns a reference to a new read-only store at a specific
+// version (height). This should be used for querying and iteration only. If the
+// version does not exist or has been pruned, an error will be returned. Any
+// mutable operations executed will result in a panic.
+func (st *Store) GetImmutable(version int64) (*Store, error) {
+	root, err := st.tree.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	st2 := &Store{db: st.db, tree: st.tree, immutable: true, version: version, root: root}
+	if root != nil {
+		st2.hash = root.hash
+	}
+
+	return st2, nil
+}
+
+// Commit commits the current store state and returns a CommitID with the new
+// version and hash.
+func (st *Store) Commit() types.CommitID {
+	defer telemetry.MeasureSince("store", "smt", "commit")
+
+	if st.immutable {
+		panic("cannot commit an immutable SMT store")
+	}
+
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	hash, version, err := st.tree.save(batch)
+	if err != nil {
+		panic(err)
+	}
+
+	for key, value := range st.dirty {
+		if value == nil {
+			batch.Delete(indexKey([]byte(key)))
+		} else {
+			batch.Set(indexKey([]byte(key)), value)
+		}
+
+		batch.Set(append(changesKey(version), key...), []byte{})
+	}
+
+	batch.Set(indexVersion, versionBytes(version))
+
+	if err := batch.Write(); err != nil {
+		panic(err)
+	}
+
+	st.hash = hash
+	st.resetIndex()
+
+	return types.CommitID{
+		Version: version,
+		Hash:    hash,
+	}
+}
+
+// Implements Committer.
+func (st *Store) LastCommitID() types.CommitID {
+	return types.CommitID{
+		Version: st.tree.version,
+		Hash:    st.hash,
+	}
+}
+
+// SetPruning panics as the versions of an SMT store are pruned by the
+// multi-store through DeleteVersions.
+func (st *Store) SetPruning(_ types.PruningOptions) {
+	panic("cannot set pruning options on an initialized SMT store")
+}
+
+// VersionExists returns whether or not a given version is stored.
+func (st *Store) VersionExists(version int64) bool {
+	return st.tree.versionExists(version)
+}
+
+// DeleteVersions deletes a series of versions from the tree. An error is
+// returned if any single version does not exist, is the latest one, or its
+// deletion fails. Each version is deleted in its own batch.
+func (st *Store) DeleteVersions(versions ...int64) error {
+	for _, version := range versions {
+		if err := st.deleteVersion(version); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (st *Store) deleteVersion(version int64) error {
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	if err := st.tree.deleteVersion(batch, version); err != nil {
+		return err
+	}
+
+	// the keys written at a version are only needed to iterate over the
+	// versions saved before it
+	it, err := st.db.Iterator(changePrefix, changesKey(st.tree.versions[0]+1))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		batch.Delete(append([]byte{}, it.Key()...))
+	}
+
+	return batch.Write()
+}
+
+// Implements Store.
+func (st *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeSMT
+}
+
+// Implements Store.
+func (st *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(st, w, tc))
+}
+
+// Implements types.KVStore.
+func (st *Store) Set(key, value []byte) {
+	defer telemetry.MeasureSince("store", "smt", "set")
+
+	if st.immutable {
+		panic("cannot set a key of an immutable SMT store")
+	}
+
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+
+	st.index.Set(key, value)
+	st.tree.set(key, value)
+	st.dirty[string(key)] = value
+}
+
+// Implements types.KVStore.
+func (st *Store) Get(key []byte) []byte {
+	defer telemetry.MeasureSince("store", "smt", "get")
+
+	if st.immutable {
+		return st.tree.get(st.root, key)
+	}
+
+	return st.index.Get(key)
+}
+
+// Implements types.KVStore.
+func (st *Store) Has(key []byte) (exists bool) {
+	defer telemetry.MeasureSince("store", "smt", "has")
+	return st.Get(key) != nil
+}
+
+// Implements types.KVStore.
+func (st *Store) Delete(key []byte) {
+	defer telemetry.MeasureSince("store", "smt", "delete")
+
+	if st.immutable {
+		panic("cannot delete a key of an immutable SMT store")
+	}
+
+	if st.tree.remove(key) {
+		st.index.Delete(key)
+		st.dirty[string(key)] = nil
+	}
+}
+
+// Implements types.KVStore.
+func (st *Store) Iterator(start, end []byte) types.Iterator {
+	if st.immutable {
+		return st.immutableIterator(start, end, true)
+	}
+
+	return st.index.Iterator(start, end)
+}
+
+// Implements types.KVStore.
+func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
+	if st.immutable {
+		return st.immutableIterator(start, end, false)
+	}
+
+	return st.index.ReverseIterator(start, end)
+}
+
+// immutableIterator iterates over the keys of an immutable store. As its
+// version may not be the indexed one, the index is iterated over with the keys
+// written between both versions read from the tree, and only these keys are
+// held in memory.
+func (st *Store) immutableIterator(start, end []byte, ascending bool) types.Iterator {
+	bz, err := st.db.Get(indexVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	from, to := st.version, int64(binary.BigEndian.Uint64(bz))
+	if from > to {
+		from, to = to, from
+	}
+
+	// the keys written between both versions are the only ones whose values
+	// may differ
+	changes := cachekv.NewStore(dbadapter.Store{DB: dbm.NewPrefixDB(st.db, indexPrefix)})
+
+	for version := from + 1; version <= to; version++ {
+		it, err := dbm.NewPrefixDB(st.db, changesKey(version)).Iterator(start, end)
+		if err != nil {
+			panic(err)
+		}
+
+		for ; it.Valid(); it.Next() {
+			if value := st.tree.get(st.root, it.Key()); value != nil {
+				changes.Set(it.Key(), value)
+			} else {
+				changes.Delete(it.Key())
+			}
+		}
+
+		it.Close()
+	}
+
+	if ascending {
+		return changes.Iterator(start, end)
+	}
+
+	return changes.ReverseIterator(start, end)
+}
+
+// getHeight returns the height to query, which is the latest height but one if
+// the request's height is 0, so that it can be proven by the latest header, and
+// the latest height if the previous one does not exist.
+func (st *Store) getHeight(req abci.RequestQuery) int64 {
+	height := req.Height
+	if height == 0 {
+		latest := st.tree.version
+		if st.VersionExists(latest - 1) {
+			height = latest - 1
+		} else {
+			height = latest
+		}
+	}
+
+	return height
+}
+
+// Query implements ABCI interface, allows queries
+//
+// As with IAVL stores, queries default to the latest height but one. Keys are
+// proven with ics23 proofs of their paths in the tree. Subspaces cannot be
+// proven, as the leaves of a subspace are not contiguous in the tree.
+func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	defer telemetry.MeasureSince("store", "smt", "query")
+
+	if len(req.Data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"))
+	}
+
+	res.Height = st.getHeight(req)
+
+	switch req.Path {
+	case "/key":
+		key := req.Data
+		res.Key = key
+
+		root, err := st.tree.getRoot(res.Height)
+		if err != nil {
+			res.Log = err.Error()
+			break
+		}
+
+		res.Value = st.tree.get(root, key)
+
+		// the absence of a key from an empty tree cannot be proven
+		if !req.Prove || root == nil {
+			break
+		}
+
+		res.Proof = st.tree.getProof(root, key)
+
+	case "/subspace":
+		if req.Prove {
+			return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "subspaces of SMT stores cannot be proven"))
+		}
+
+		subspace := req.Data
+		res.Key = subspace
+
+		immutable, err := st.GetImmutable(res.Height)
+		if err != nil {
+			res.Log = err.Error()
+			break
+		}
+
+		var kvs []types.KVPair
+
+		iterator := types.KVStorePrefixIterator(immutable, subspace)
+		for ; iterator.Valid(); iterator.Next() {
+			kvs = append(kvs, types.KVPair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+
+		iterator.Close()
+		res.Value = cdc.MustMarshalBinaryBare(kvs)
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path))
+	}
+
+	return res
+}
@@ -0,0 +1,547 @@
+package smt
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newStore(t testing.TB, db dbm.DB, version int64) *Store {
+	store, err := LoadStore(db, types.CommitID{Version: version})
+	require.NoError(t, err)
+
+	return store.(*Store)
+}
+
+func verifyProof(t *testing.T, proof *merkle.Proof, root, key, value []byte) error {
+	require.NotNil(t, proof)
+	require.Len(t, proof.Ops, 1)
+
+	op, err := types.CommitmentOpDecoder(proof.Ops[0])
+	require.NoError(t, err)
+	require.Equal(t, key, op.GetKey())
+
+	var args [][]byte
+	if value != nil {
+		args = [][]byte{value}
+	}
+
+	res, err := op.Run(args)
+	if err != nil {
+		return err
+	}
+
+	require.Equal(t, [][]byte{root}, res)
+
+	return nil
+}
+
+func TestSMTStoreGetSetHasDelete(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), 0)
+
+	key := []byte("hello")
+	require.Nil(t, store.Get(key))
+	require.False(t, store.Has(key))
+
+	store.Set(key, []byte("goodbye"))
+	require.Equal(t, []byte("goodbye"), store.Get(key))
+	require.True(t, store.Has(key))
+
+	store.Delete(key)
+	require.Nil(t, store.Get(key))
+	require.False(t, store.Has(key))
+
+	require.Panics(t, func() { store.Set(key, nil) })
+	require.Panics(t, func() { store.Set(nil, []byte("value")) })
+}
+
+func TestSMTStoreHashIsOrderIndependent(t *testing.T) {
+	keys := make([][]byte, 100)
+	for i := range keys {
+		keys[i] = []byte(fmt.Sprintf("key%03d", i))
+	}
+
+	store1 := newStore(t, dbm.NewMemDB(), 0)
+	for _, key := range keys {
+		store1.Set(key, key)
+	}
+
+	store2 := newStore(t, dbm.NewMemDB(), 0)
+	for _, i := range rand.Perm(len(keys)) {
+		store2.Set(keys[i], keys[i])
+	}
+
+	// keys set and deleted before committing leave no trace
+	store2.Set([]byte("deleted"), []byte("value"))
+	store2.Delete([]byte("deleted"))
+
+	require.Equal(t, store1.Commit(), store2.Commit())
+
+	// deleting keys after committing gives the hash of a tree without them
+	store3 := newStore(t, dbm.NewMemDB(), 0)
+	for _, key := range keys[:50] {
+		store3.Set(key, key)
+	}
+
+	for _, key := range keys[50:] {
+		store1.Delete(key)
+	}
+
+	require.Equal(t, store3.Commit().Hash, store1.Commit().Hash)
+
+	// deleting all keys gives an empty tree
+	for _, key := range keys[:50] {
+		store1.Delete(key)
+	}
+
+	require.Nil(t, store1.Commit().Hash)
+}
+
+func TestSMTStoreCommitAndLoad(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newStore(t, db, 0)
+
+	store.Set([]byte("hello"), []byte("goodbye"))
+	store.Set([]byte("aloha"), []byte("shalom"))
+	cid1 := store.Commit()
+	require.Equal(t, int64(1), cid1.Version)
+
+	store.Set([]byte("hello"), []byte("adios"))
+	store.Delete([]byte("aloha"))
+	cid2 := store.Commit()
+	require.Equal(t, int64(2), cid2.Version)
+	require.NotEqual(t, cid1.Hash, cid2.Hash)
+
+	// the latest version is loaded from the index
+	store = newStore(t, db, 2)
+	require.Equal(t, cid2, store.LastCommitID())
+	require.Equal(t, []byte("adios"), store.Get([]byte("hello")))
+	require.Nil(t, store.Get([]byte("aloha")))
+
+	// loading an earlier version rebuilds the index
+	store = newStore(t, db, 1)
+	require.Equal(t, cid1, store.LastCommitID())
+	require.Equal(t, []byte("goodbye"), store.Get([]byte("hello")))
+	require.Equal(t, []byte("shalom"), store.Get([]byte("aloha")))
+
+	// the next version must be committed with the same hash
+	store.Set([]byte("hello"), []byte("ciao"))
+	require.Panics(t, func() { store.Commit() })
+
+	_, err := LoadStore(db, types.CommitID{Version: 3})
+	require.Error(t, err)
+}
+
+func TestSMTStoreIterator(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newStore(t, db, 0)
+
+	for i := 0; i < 20; i++ {
+		store.Set([]byte(fmt.Sprintf("key%02d", i)), []byte{byte(i)})
+	}
+
+	store.Commit()
+	store.Delete([]byte("key05"))
+	store.Set([]byte("key20"), []byte{20})
+
+	collect := func(it types.Iterator) (keys []string) {
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, string(it.Key()))
+		}
+
+		return keys
+	}
+
+	require.Equal(t, []string{"key04", "key06", "key07"}, collect(store.Iterator([]byte("key04"), []byte("key08"))))
+	require.Equal(t, []string{"key20", "key19"}, collect(store.ReverseIterator([]byte("key19"), nil)))
+
+	immutable, err := store.GetImmutable(1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"key04", "key05", "key06", "key07"}, collect(immutable.Iterator([]byte("key04"), []byte("key08"))))
+	require.Equal(t, []string{"key19", "key18"}, collect(immutable.ReverseIterator([]byte("key18"), nil)))
+
+	// earlier versions are iterated over once later ones are indexed
+	store.Commit()
+	store.Set([]byte("key05"), []byte{5})
+	store.Delete([]byte("key06"))
+	store.Commit()
+
+	require.Equal(t, []string{"key04", "key05", "key06", "key07"}, collect(immutable.Iterator([]byte("key04"), []byte("key08"))))
+	require.Equal(t, []string{"key19", "key18"}, collect(immutable.ReverseIterator([]byte("key18"), nil)))
+
+	immutable, err = store.GetImmutable(2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"key04", "key06", "key07"}, collect(immutable.Iterator([]byte("key04"), []byte("key08"))))
+	require.Equal(t, []string{"key20", "key19"}, collect(immutable.ReverseIterator([]byte("key19"), nil)))
+
+	immutable, err = store.GetImmutable(3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"key04", "key05", "key07"}, collect(immutable.Iterator([]byte("key04"), []byte("key08"))))
+}
+
+func TestSMTStoreGetImmutable(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), 0)
+
+	store.Set([]byte("hello"), []byte("goodbye"))
+	cid1 := store.Commit()
+
+	store.Set([]byte("hello"), []byte("adios"))
+	store.Commit()
+
+	_, err := store.GetImmutable(3)
+	require.Equal(t, ErrVersionDoesNotExist, err)
+
+	immutable, err := store.GetImmutable(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("goodbye"), immutable.Get([]byte("hello")))
+	require.Equal(t, cid1.Hash, immutable.LastCommitID().Hash)
+
+	require.Panics(t, func() { immutable.Set([]byte("hello"), []byte("ciao")) })
+	require.Panics(t, func() { immutable.Delete([]byte("hello")) })
+	require.Panics(t, func() { immutable.Commit() })
+}
+
+func TestSMTStoreQuery(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), 0)
+
+	// the absence of a key from an empty tree cannot be proven
+	res := store.Query(abci.RequestQuery{Path: "/key", Data: []byte("key"), Height: 0, Prove: true})
+	require.EqualValues(t, 0, res.Code)
+	require.Nil(t, res.Proof)
+
+	for i := 0; i < 100; i += 2 {
+		store.Set([]byte(fmt.Sprintf("key%02d", i)), []byte(fmt.Sprintf("value%02d", i)))
+	}
+
+	cid := store.Commit()
+
+	// keys are proven against the version they are read from
+	store.Set([]byte("key00"), []byte("changed"))
+	store.Commit()
+
+	for i := 0; i < 100; i++ {
+		key := []byte(fmt.Sprintf("key%02d", i))
+
+		res := store.Query(abci.RequestQuery{Path: "/key", Data: key, Height: cid.Version, Prove: true})
+		require.EqualValues(t, 0, res.Code, res.Log)
+		require.Equal(t, cid.Version, res.Height)
+
+		if i%2 == 0 {
+			value := []byte(fmt.Sprintf("value%02d", i))
+			require.Equal(t, value, res.Value)
+			require.NoError(t, verifyProof(t, res.Proof, cid.Hash, key, value))
+			require.Error(t, verifyProof(t, res.Proof, cid.Hash, key, []byte("wrong")))
+		} else {
+			require.Nil(t, res.Value)
+			require.NoError(t, verifyProof(t, res.Proof, cid.Hash, key, nil))
+		}
+	}
+
+	// the latest height but one is queried by default
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("key00")})
+	require.Equal(t, cid.Version, res.Height)
+	require.Equal(t, []byte("value00"), res.Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("key00"), Height: 3})
+	require.Equal(t, ErrVersionDoesNotExist.Error(), res.Log)
+
+	res = store.Query(abci.RequestQuery{Path: "/subspace", Data: []byte("key0"), Height: 2})
+	require.EqualValues(t, 0, res.Code)
+
+	var kvs []types.KVPair
+	require.NoError(t, cdc.UnmarshalBinaryBare(res.Value, &kvs))
+	require.Len(t, kvs, 5)
+	require.Equal(t, []byte("changed"), kvs[0].Value)
+
+	// subspaces are read from the queried height
+	res = store.Query(abci.RequestQuery{Path: "/subspace", Data: []byte("key0"), Height: cid.Version})
+	require.EqualValues(t, 0, res.Code)
+
+	kvs = nil
+	require.NoError(t, cdc.UnmarshalBinaryBare(res.Value, &kvs))
+	require.Len(t, kvs, 5)
+	require.Equal(t, []byte("value00"), kvs[0].Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/subspace", Data: []byte("key0"), Height: 3})
+	require.Equal(t, ErrVersionDoesNotExist.Error(), res.Log)
+
+	res = store.Query(abci.RequestQuery{Path: "/subspace", Data: []byte("key0"), Prove: true})
+	require.NotEqualValues(t, 0, res.Code)
+
+	res = store.Query(abci.RequestQuery{Path: "/unknown", Data: []byte("key0")})
+	require.NotEqualValues(t, 0, res.Code)
+}
+
+func TestSMTStoreProofs(t *testing.T) {
+	for _, size := range []int{1, 2, 3, 17, 200} {
+		store := newStore(t, dbm.NewMemDB(), 0)
+
+		for i := 0; i < size; i++ {
+			store.Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+		}
+
+		cid := store.Commit()
+
+		for i := 0; i < 2*size+10; i++ {
+			key := []byte(fmt.Sprintf("key%d", i))
+
+			res := store.Query(abci.RequestQuery{Path: "/key", Data: key, Height: cid.Version, Prove: true})
+			require.EqualValues(t, 0, res.Code, res.Log)
+			require.NoError(t, verifyProof(t, res.Proof, cid.Hash, key, res.Value), "size %d, key %s", size, key)
+		}
+	}
+}
+
+// countNodes returns the number of nodes saved in a store's database.
+func countNodes(t *testing.T, db dbm.DB) (count int) {
+	it, err := dbm.IteratePrefix(db, nodePrefix)
+	require.NoError(t, err)
+
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+
+	return count
+}
+
+// reachableNodes returns the number of distinct nodes of the saved versions of
+// a store.
+func reachableNodes(t *testing.T, store *Store) int {
+	nodes := map[string]bool{}
+
+	var visit func(n *node)
+	visit = func(n *node) {
+		nodes[string(nodeKey(n.ref()))] = true
+
+		if !n.leaf {
+			visit(store.tree.child(n, 0))
+			visit(store.tree.child(n, 1))
+		}
+	}
+
+	for _, version := range store.tree.versions {
+		root, err := store.tree.getRoot(version)
+		require.NoError(t, err)
+
+		if root != nil {
+			visit(root)
+		}
+	}
+
+	return len(nodes)
+}
+
+func TestSMTStorePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newStore(t, db, 0)
+
+	values := make(map[int64]map[string][]byte)
+
+	for version := int64(1); version <= 10; version++ {
+		values[version] = make(map[string][]byte)
+
+		for i := 0; i < 10; i++ {
+			key := fmt.Sprintf("key%d", rand.Intn(30))
+			if rand.Intn(4) == 0 {
+				store.Delete([]byte(key))
+			} else {
+				store.Set([]byte(key), []byte(fmt.Sprintf("value%d", version)))
+			}
+		}
+
+		it := store.Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			values[version][string(it.Key())] = it.Value()
+		}
+
+		it.Close()
+
+		require.Equal(t, version, store.Commit().Version)
+	}
+
+	require.Equal(t, reachableNodes(t, store), countNodes(t, db))
+
+	require.NoError(t, store.DeleteVersions(2, 3, 7))
+	require.NoError(t, store.DeleteVersions(1, 9, 5))
+	require.Equal(t, reachableNodes(t, store), countNodes(t, db))
+
+	require.Equal(t, ErrVersionDoesNotExist, store.DeleteVersions(2))
+	require.Error(t, store.DeleteVersions(10))
+
+	for version := int64(1); version <= 10; version++ {
+		immutable, err := store.GetImmutable(version)
+
+		switch version {
+		case 1, 2, 3, 5, 7, 9:
+			require.Equal(t, ErrVersionDoesNotExist, err)
+			require.False(t, store.VersionExists(version))
+
+		default:
+			require.NoError(t, err)
+			require.True(t, store.VersionExists(version))
+
+			for key, value := range values[version] {
+				require.Equal(t, value, immutable.Get([]byte(key)))
+			}
+
+			it := immutable.Iterator(nil, nil)
+			iterated := make(map[string][]byte)
+
+			for ; it.Valid(); it.Next() {
+				iterated[string(it.Key())] = it.Value()
+			}
+
+			it.Close()
+			require.Equal(t, values[version], iterated)
+		}
+	}
+
+	// the keys written at the versions up to the earliest saved one are deleted
+	it, err := db.Iterator(changePrefix, changesKey(5))
+	require.NoError(t, err)
+	require.False(t, it.Valid())
+	it.Close()
+
+	// the deleted versions are not loaded again
+	store = newStore(t, db, 10)
+	require.False(t, store.VersionExists(9))
+	require.True(t, store.VersionExists(8))
+}
+
+func BenchmarkSMTStore(b *testing.B) {
+	benchmarkStore(b, func(db dbm.DB) types.CommitKVStore {
+		store, err := LoadStore(db, types.CommitID{})
+		require.NoError(b, err)
+
+		return store
+	})
+}
+
+func BenchmarkIAVLStore(b *testing.B) {
+	benchmarkStore(b, func(db dbm.DB) types.CommitKVStore {
+		store, err := iavl.LoadStore(db, types.CommitID{}, false)
+		require.NoError(b, err)
+
+		return store
+	})
+}
+
+const (
+	// benchmarkKeys is the number of keys a store is filled with before being
+	// benchmarked
+	benchmarkKeys = 10000
+	// benchmarkBlockKeys is the number of keys set between two commits
+	benchmarkBlockKeys = 1000
+)
+
+// benchmarkStore benchmarks the operations of a store on a write-heavy
+// workload, setting random keys and committing every benchmarkBlockKeys keys.
+func benchmarkStore(b *testing.B, newStore func(db dbm.DB) types.CommitKVStore) {
+	// fill returns a store filled with benchmarkKeys random keys, and the keys
+	fill := func(r *rand.Rand) (types.CommitKVStore, [][]byte) {
+		store := newStore(dbm.NewMemDB())
+		keys := make([][]byte, benchmarkKeys)
+
+		for i := range keys {
+			keys[i] = randomKey(r)
+			store.Set(keys[i], keys[i])
+
+			if i%benchmarkBlockKeys == benchmarkBlockKeys-1 {
+				store.Commit()
+			}
+		}
+
+		store.Commit()
+
+		return store, keys
+	}
+
+	b.Run("Set", func(b *testing.B) {
+		r := rand.New(rand.NewSource(0))
+		store, _ := fill(r)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			key := randomKey(r)
+			store.Set(key, key)
+
+			if i%benchmarkBlockKeys == benchmarkBlockKeys-1 {
+				b.StopTimer()
+				store.Commit()
+				b.StartTimer()
+			}
+		}
+	})
+
+	b.Run("Get", func(b *testing.B) {
+		r := rand.New(rand.NewSource(0))
+		store, keys := fill(r)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			store.Get(keys[r.Intn(len(keys))])
+		}
+	})
+
+	b.Run("Commit", func(b *testing.B) {
+		r := rand.New(rand.NewSource(0))
+		store, _ := fill(r)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+
+			for j := 0; j < benchmarkBlockKeys; j++ {
+				key := randomKey(r)
+				store.Set(key, key)
+			}
+
+			b.StartTimer()
+			store.Commit()
+		}
+	})
+
+	b.Run("Iterator", func(b *testing.B) {
+		r := rand.New(rand.NewSource(0))
+		store, _ := fill(r)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		// iterate over a hundredth of the keys from a random key
+		for i := 0; i < b.N; i++ {
+			it := store.Iterator(randomKey(r), nil)
+			for j := 0; j < benchmarkKeys/100 && it.Valid(); j++ {
+				_ = it.Value()
+				it.Next()
+			}
+
+			it.Close()
+		}
+	})
+}
+
+func randomKey(r *rand.Rand) []byte {
+	key := make([]byte, 32)
+	r.Read(key)
+
+	return key
+}
@@ -0,0 +1,680 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	lru "github.com/hashicorp/golang-lru"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const defaultNodeCacheSize = 10000
+
+// ErrVersionDoesNotExist is returned when a version of the tree does not exist
+// or has been deleted.
+var ErrVersionDoesNotExist = errors.New("version does not exist")
+
+var (
+	nodePrefix   = []byte("n") // n<version><hash> -> node
+	rootPrefix   = []byte("r") // r<version> -> root node reference, empty if the tree is empty
+	orphanPrefix = []byte("o") // o<to version><from version><hash> -> nothing
+
+	leafPrefix  = []byte{0}
+	innerPrefix = []byte{1}
+)
+
+// nodeRef references a saved node by the version it was created at and its
+// hash.
+type nodeRef struct {
+	version int64
+	hash    []byte
+}
+
+func (ref nodeRef) bytes() []byte {
+	bz := make([]byte, 8, 8+len(ref.hash))
+	binary.BigEndian.PutUint64(bz, uint64(ref.version))
+
+	return append(bz, ref.hash...)
+}
+
+func nodeRefFromBytes(bz []byte) (nodeRef, error) {
+	if len(bz) != 8+sha256.Size {
+		return nodeRef{}, fmt.Errorf("invalid node reference of %d bytes", len(bz))
+	}
+
+	return nodeRef{version: int64(binary.BigEndian.Uint64(bz)), hash: bz[8:]}, nil
+}
+
+// node is a node of a sparse Merkle tree. Leaves are placed at the path given by
+// the SHA-256 hash of their key. Empty subtrees are omitted and inner nodes with
+// a single child collapsed, so that inner nodes always have two children and
+// split their leaves at the first bit their paths differ at. As leaves are
+// ordered by path, neighboring leaves can be proven with ics23 proofs.
+//
+// Saved nodes are immutable. Nodes created since the last saved version are
+// mutable, and have no hash until they are saved.
+type node struct {
+	version int64
+	hash    []byte
+	saved   bool
+
+	// leaf fields
+	leaf  bool
+	key   []byte
+	value []byte
+	path  []byte
+
+	// inner fields; a child is referenced by its node if it was not saved yet,
+	// and by its reference otherwise
+	bit      int
+	children [2]*node
+	refs     [2]nodeRef
+}
+
+func (n *node) ref() nodeRef {
+	return nodeRef{version: n.version, hash: n.hash}
+}
+
+// encode encodes a node for storage. Leaves are encoded as their key and value,
+// inner nodes as their split bit and the references of their children.
+func (n *node) encode() []byte {
+	var buf bytes.Buffer
+
+	if n.leaf {
+		buf.Write(leafPrefix)
+		writeBytes(&buf, n.key)
+		writeBytes(&buf, n.value)
+
+		return buf.Bytes()
+	}
+
+	buf.Write(innerPrefix)
+	writeUvarint(&buf, uint64(n.bit))
+	buf.Write(n.refs[0].bytes())
+	buf.Write(n.refs[1].bytes())
+
+	return buf.Bytes()
+}
+
+func decodeNode(ref nodeRef, bz []byte) (*node, error) {
+	if len(bz) == 0 {
+		return nil, errors.New("empty node")
+	}
+
+	n := &node{version: ref.version, hash: ref.hash, saved: true}
+	r := bytes.NewReader(bz[1:])
+
+	switch bz[0] {
+	case leafPrefix[0]:
+		key, err := readBytes(r)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := readBytes(r)
+		if err != nil {
+			return nil, err
+		}
+
+		path := sha256.Sum256(key)
+		n.leaf, n.key, n.value, n.path = true, key, value, path[:]
+
+	case innerPrefix[0]:
+		bit, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+
+		n.bit = int(bit)
+
+		for i := range n.refs {
+			refBz := make([]byte, 8+sha256.Size)
+			if _, err := io.ReadFull(r, refBz); err != nil {
+				return nil, err
+			}
+
+			if n.refs[i], err = nodeRefFromBytes(refBz); err != nil {
+				return nil, err
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown node type %d", bz[0])
+	}
+
+	return n, nil
+}
+
+// leafHash returns the hash of a leaf, hashing its path and the SHA-256 hash of
+// its value, as specified by the LeafOp of types.SmtSpec.
+func leafHash(path, value []byte) []byte {
+	valueHash := sha256.Sum256(value)
+
+	h := sha256.New()
+	h.Write(leafPrefix)
+	h.Write(path)
+	h.Write(valueHash[:])
+
+	return h.Sum(nil)
+}
+
+// innerHash returns the hash of an inner node, as specified by the InnerSpec of
+// types.SmtSpec.
+func innerHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write(innerPrefix)
+	h.Write(left)
+	h.Write(right)
+
+	return h.Sum(nil)
+}
+
+// bitAt returns the bit of the given path at the given index, the most
+// significant bit of the first byte being at index 0.
+func bitAt(path []byte, i int) int {
+	return int(path[i/8]>>(7-uint(i%8))) & 1
+}
+
+// critBit returns the index of the first bit at which the given paths differ,
+// or -1 if they are equal.
+func critBit(a, b []byte) int {
+	for i := range a {
+		if x := a[i] ^ b[i]; x != 0 {
+			for j := 0; j < 8; j++ {
+				if x&(0x80>>uint(j)) != 0 {
+					return 8*i + j
+				}
+			}
+		}
+	}
+
+	return -1
+}
+
+// tree is a versioned sparse Merkle tree stored in a database. Its working
+// root is modified in memory and saved as a new version, while the nodes no
+// longer referenced by the new version are recorded as orphans, to be deleted
+// once no saved version references them.
+type tree struct {
+	db    dbm.DB
+	cache *lru.Cache
+
+	// versions are the saved versions, in ascending order
+	versions []int64
+	version  int64
+	root     *node
+	orphans  []nodeRef
+}
+
+// loadTree loads the given version of the tree from the database, or an empty
+// tree if the version is 0.
+func loadTree(db dbm.DB, version int64) (*tree, error) {
+	cache, err := lru.New(defaultNodeCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &tree{db: db, cache: cache}
+
+	it, err := dbm.IteratePrefix(db, rootPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		t.versions = append(t.versions, int64(binary.BigEndian.Uint64(it.Key()[len(rootPrefix):])))
+	}
+
+	if version == 0 {
+		return t, nil
+	}
+
+	root, err := t.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	t.version, t.root = version, root
+
+	return t, nil
+}
+
+func rootKey(version int64) []byte {
+	key := make([]byte, len(rootPrefix)+8)
+	copy(key, rootPrefix)
+	binary.BigEndian.PutUint64(key[len(rootPrefix):], uint64(version))
+
+	return key
+}
+
+func nodeKey(ref nodeRef) []byte {
+	return append(append([]byte{}, nodePrefix...), ref.bytes()...)
+}
+
+// orphansKey returns the prefix of the keys of the nodes orphaned after the
+// given version.
+func orphansKey(toVersion int64) []byte {
+	key := make([]byte, len(orphanPrefix)+8)
+	copy(key, orphanPrefix)
+	binary.BigEndian.PutUint64(key[len(orphanPrefix):], uint64(toVersion))
+
+	return key
+}
+
+func orphanKey(toVersion int64, ref nodeRef) []byte {
+	return append(orphansKey(toVersion), ref.bytes()...)
+}
+
+// versionExists returns whether the given version is saved.
+func (t *tree) versionExists(version int64) bool {
+	i := sort.Search(len(t.versions), func(i int) bool { return t.versions[i] >= version })
+	return i < len(t.versions) && t.versions[i] == version
+}
+
+// getRoot returns the root of the given saved version, which is nil if the tree
+// was empty.
+func (t *tree) getRoot(version int64) (*node, error) {
+	if !t.versionExists(version) {
+		return nil, ErrVersionDoesNotExist
+	}
+
+	bz, err := t.db.Get(rootKey(version))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	ref, err := nodeRefFromBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.getNode(ref)
+}
+
+// getNode loads a saved node.
+func (t *tree) getNode(ref nodeRef) (*node, error) {
+	key := nodeKey(ref)
+
+	if n, ok := t.cache.Get(string(key)); ok {
+		return n.(*node), nil
+	}
+
+	bz, err := t.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if bz == nil {
+		return nil, fmt.Errorf("node %X of version %d not found", ref.hash, ref.version)
+	}
+
+	n, err := decodeNode(ref, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	t.cache.Add(string(key), n)
+
+	return n, nil
+}
+
+// child returns the child of an inner node on the given side, 0 for left and 1
+// for right. It panics if a saved child cannot be loaded, as the tree is then
+// corrupted.
+func (t *tree) child(n *node, side int) *node {
+	if c := n.children[side]; c != nil {
+		return c
+	}
+
+	c, err := t.getNode(n.refs[side])
+	if err != nil {
+		panic(fmt.Sprintf("failed to load node: %s", err))
+	}
+
+	return c
+}
+
+// get returns the value of the given key under the given root, or nil.
+func (t *tree) get(root *node, key []byte) []byte {
+	if root == nil {
+		return nil
+	}
+
+	path := sha256.Sum256(key)
+
+	n := root
+	for !n.leaf {
+		n = t.child(n, bitAt(path[:], n.bit))
+	}
+
+	if !bytes.Equal(n.key, key) {
+		return nil
+	}
+
+	return n.value
+}
+
+// set sets the value of a key in the working tree.
+func (t *tree) set(key, value []byte) {
+	path := sha256.Sum256(key)
+	leaf := &node{version: t.version + 1, leaf: true, key: key, value: value, path: path[:]}
+
+	if t.root == nil {
+		t.root = leaf
+		return
+	}
+
+	// find the leaf whose path shares the longest prefix with the key's
+	closest := t.root
+	for !closest.leaf {
+		closest = t.child(closest, bitAt(leaf.path, closest.bit))
+	}
+
+	crit := critBit(leaf.path, closest.path)
+	if crit < 0 && bytes.Equal(closest.value, value) {
+		return
+	}
+
+	t.root = t.insert(t.root, leaf, crit)
+}
+
+// insert inserts a leaf under the given node, splitting at the given bit, or
+// replacing the leaf with the same path if the bit is negative, and returns the
+// new node.
+func (t *tree) insert(n *node, leaf *node, crit int) *node {
+	switch {
+	case n.leaf && crit < 0:
+		t.orphan(n)
+		return leaf
+
+	case n.leaf || (crit >= 0 && n.bit > crit):
+		inner := &node{version: t.version + 1, bit: crit}
+
+		side := bitAt(leaf.path, crit)
+		inner.setChild(t, side, leaf)
+		inner.setChild(t, 1-side, n)
+
+		return inner
+	}
+
+	side := bitAt(leaf.path, n.bit)
+
+	return t.withChild(n, side, t.insert(t.child(n, side), leaf, crit))
+}
+
+// remove removes a key from the working tree, and returns whether it existed.
+func (t *tree) remove(key []byte) bool {
+	if t.root == nil {
+		return false
+	}
+
+	path := sha256.Sum256(key)
+
+	root, removed := t.removeFrom(t.root, path[:])
+	if removed {
+		t.root = root
+	}
+
+	return removed
+}
+
+func (t *tree) removeFrom(n *node, path []byte) (*node, bool) {
+	if n.leaf {
+		if !bytes.Equal(n.path, path) {
+			return n, false
+		}
+
+		t.orphan(n)
+
+		return nil, true
+	}
+
+	side := bitAt(path, n.bit)
+
+	c, removed := t.removeFrom(t.child(n, side), path)
+	if !removed {
+		return n, false
+	}
+
+	// collapse the node into its remaining child
+	if c == nil {
+		t.orphan(n)
+		return t.child(n, 1-side), true
+	}
+
+	return t.withChild(n, side, c), true
+}
+
+// withChild returns the node with its child on the given side replaced,
+// modifying it if it was not saved yet and copying it otherwise.
+func (t *tree) withChild(n *node, side int, c *node) *node {
+	if n.saved {
+		t.orphan(n)
+
+		copied := &node{version: t.version + 1, bit: n.bit, children: n.children, refs: n.refs}
+		n = copied
+	}
+
+	n.setChild(t, side, c)
+
+	return n
+}
+
+func (n *node) setChild(t *tree, side int, c *node) {
+	if c.saved {
+		n.children[side], n.refs[side] = nil, c.ref()
+	} else {
+		n.children[side], n.refs[side] = c, nodeRef{}
+	}
+}
+
+// orphan records that a node is no longer part of the working tree.
+func (t *tree) orphan(n *node) {
+	if n.saved {
+		t.orphans = append(t.orphans, n.ref())
+	}
+}
+
+// hash returns the hash of the working tree, saving no node.
+func (t *tree) hash() []byte {
+	if t.root == nil {
+		return nil
+	}
+
+	return t.hashNode(t.root)
+}
+
+func (t *tree) hashNode(n *node) []byte {
+	if n.saved {
+		return n.hash
+	}
+
+	if n.leaf {
+		return leafHash(n.path, n.value)
+	}
+
+	var hashes [2][]byte
+	for side := range hashes {
+		if c := n.children[side]; c != nil {
+			hashes[side] = t.hashNode(c)
+		} else {
+			hashes[side] = n.refs[side].hash
+		}
+	}
+
+	return innerHash(hashes[0], hashes[1])
+}
+
+// save writes the working tree as the next version to the given batch, with the
+// nodes it orphans, and returns its hash and version. The tree must not be used
+// until the batch is written.
+func (t *tree) save(batch dbm.Batch) ([]byte, int64, error) {
+	version := t.version + 1
+
+	if t.versionExists(version) {
+		existing, err := t.getRoot(version)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var existingHash []byte
+		if existing != nil {
+			existingHash = existing.hash
+		}
+
+		if !bytes.Equal(existingHash, t.hash()) {
+			return nil, 0, fmt.Errorf("version %d was already saved with a different hash", version)
+		}
+	}
+
+	var rootRef []byte
+	if t.root != nil {
+		t.saveNode(batch, t.root)
+		rootRef = t.root.ref().bytes()
+	}
+
+	batch.Set(rootKey(version), rootRef)
+
+	for _, ref := range t.orphans {
+		batch.Set(orphanKey(version-1, ref), []byte{})
+	}
+
+	if !t.versionExists(version) {
+		t.versions = append(t.versions, version)
+	}
+
+	t.version = version
+	t.orphans = nil
+
+	if t.root == nil {
+		return nil, version, nil
+	}
+
+	return t.root.hash, version, nil
+}
+
+// saveNode saves the nodes of a subtree which were not saved yet, children
+// first, so that the hashes of their parents can be computed.
+func (t *tree) saveNode(batch dbm.Batch, n *node) {
+	if n.saved {
+		return
+	}
+
+	if n.leaf {
+		n.hash = leafHash(n.path, n.value)
+	} else {
+		for side, c := range n.children {
+			if c != nil {
+				t.saveNode(batch, c)
+				n.children[side], n.refs[side] = nil, c.ref()
+			}
+		}
+
+		n.hash = innerHash(n.refs[0].hash, n.refs[1].hash)
+	}
+
+	n.saved = true
+	batch.Set(nodeKey(n.ref()), n.encode())
+}
+
+// deleteVersion deletes a saved version, which must not be the latest, from
+// the tree, writing the deletions to the given batch. The nodes orphaned by the
+// version's successor are deleted if no earlier saved version references them,
+// and otherwise recorded as orphans of the version's predecessor.
+func (t *tree) deleteVersion(batch dbm.Batch, version int64) error {
+	if !t.versionExists(version) {
+		return ErrVersionDoesNotExist
+	}
+
+	if version == t.version {
+		return fmt.Errorf("cannot delete latest saved version %d", version)
+	}
+
+	i := sort.Search(len(t.versions), func(i int) bool { return t.versions[i] >= version })
+
+	var predecessor int64
+	if i > 0 {
+		predecessor = t.versions[i-1]
+	}
+
+	it, err := dbm.IteratePrefix(t.db, orphansKey(version))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := append([]byte{}, it.Key()...)
+
+		ref, err := nodeRefFromBytes(key[len(orphanPrefix)+8:])
+		if err != nil {
+			return err
+		}
+
+		batch.Delete(key)
+
+		if ref.version > predecessor {
+			batch.Delete(nodeKey(ref))
+			t.cache.Remove(string(nodeKey(ref)))
+		} else {
+			batch.Set(orphanKey(predecessor, ref), []byte{})
+		}
+	}
+
+	batch.Delete(rootKey(version))
+	t.versions = append(t.versions[:i], t.versions[i+1:]...)
+
+	return nil
+}
+
+// leaves calls the given function for each leaf under the given root, in path
+// order.
+func (t *tree) leaves(root *node, fn func(n *node)) {
+	if root == nil {
+		return
+	}
+
+	if root.leaf {
+		fn(root)
+		return
+	}
+
+	t.leaves(t.child(root, 0), fn)
+	t.leaves(t.child(root, 1), fn)
+}
+
+func writeUvarint(buf *bytes.Buffer, n uint64) {
+	bz := make([]byte, binary.MaxVarintLen64)
+	buf.Write(bz[:binary.PutUvarint(bz, n)])
+}
+
+func writeBytes(buf *bytes.Buffer, bz []byte) {
+	writeUvarint(buf, uint64(len(bz)))
+	buf.Write(bz)
+}
+
+func readBytes(r *bytes.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if n > uint64(r.Len()) {
+		return nil, fmt.Errorf("invalid length %d", n)
+	}
+
+	bz := make([]byte, n)
+	_, err = io.ReadFull(r, bz)
+
+	return bz, err
+}
@@ -0,0 +1,7 @@
+package smt
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var cdc = codec.New()
@@ -15,3 +15,9 @@ func NewCommitMultiStore(db dbm.DB) types.CommitMultiStore {
 func NewCommitKVStoreCacheManager() types.MultiStorePersistentCache {
 	return cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
 }
+
+// NewWriteBackCommitKVStoreCacheManager returns an inter-block cache which holds
+// the writes made to the stores until they are committed.
+func NewWriteBackCommitKVStoreCacheManager() types.MultiStorePersistentCache {
+	return cache.NewWriteBackCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
+}
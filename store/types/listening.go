@@ -0,0 +1,44 @@
+package types
+
+import (
+	"io"
+
+	protoio "github.com/gogo/protobuf/io"
+)
+
+// WriteListener defines an interface for streaming state changes (Sets and
+// Deletes) out of a listenkv.Store.
+type WriteListener interface {
+	// OnWrite is called for every Set and Delete in the order they are applied.
+	// The storeKey identifies the originating KVStore, so the same WriteListener
+	// can be registered with several stores. If delete is true the value is nil.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
+
+// StoreKVPairWriteListener is used to configure listening to a KVStore by
+// writing out length-prefixed protobuf encoded StoreKVPairs to an underlying
+// io.Writer.
+type StoreKVPairWriteListener struct {
+	writer protoio.Writer
+}
+
+// NewStoreKVPairWriteListener creates a StoreKVPairWriteListener with a
+// provided io.Writer.
+func NewStoreKVPairWriteListener(w io.Writer) *StoreKVPairWriteListener {
+	return &StoreKVPairWriteListener{
+		writer: protoio.NewDelimitedWriter(w),
+	}
+}
+
+// OnWrite satisfies the WriteListener interface by writing length-prefixed
+// protobuf encoded StoreKVPairs.
+func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	kvPair := &StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	}
+
+	return wl.writer.WriteMsg(kvPair)
+}
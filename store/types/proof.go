@@ -1,6 +1,9 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
+
 	ics23 "github.com/confio/ics23/go"
 	"github.com/tendermint/tendermint/crypto/merkle"
 
@@ -10,8 +13,33 @@ import (
 const (
 	ProofOpIAVLCommitment         = "ics23:iavl"
 	ProofOpSimpleMerkleCommitment = "ics23:simple"
+	ProofOpIAVLRange              = "ics23:iavl-range"
+	ProofOpSMTCommitment          = "ics23:smt"
 )
 
+// SmtSpec constrains the format of the ics23 proofs of the sparse Merkle trees
+// of SMT stores. The keys of the proofs are the SHA-256 hashes of the store keys,
+// which are the paths of the leaves in the tree. Leaves hash their path and the
+// SHA-256 hash of their value with a 0x00 prefix, and inner nodes, which always
+// have two children, hash them with a 0x01 prefix.
+var SmtSpec = &ics23.ProofSpec{
+	LeafSpec: &ics23.LeafOp{
+		Hash:         ics23.HashOp_SHA256,
+		PrehashKey:   ics23.HashOp_NO_HASH,
+		PrehashValue: ics23.HashOp_SHA256,
+		Length:       ics23.LengthOp_NO_PREFIX,
+		Prefix:       []byte{0},
+	},
+	InnerSpec: &ics23.InnerSpec{
+		ChildOrder:      []int32{0, 1},
+		ChildSize:       sha256.Size,
+		MinPrefixLength: 1,
+		MaxPrefixLength: 1,
+		Hash:            ics23.HashOp_SHA256,
+	},
+	MaxDepth: 8 * sha256.Size,
+}
+
 // CommitmentOp implements merkle.ProofOperator by wrapping an ics23 CommitmentProof
 // It also contains a Key field to determine which key the proof is proving.
 // NOTE: CommitmentProof currently can either be ExistenceProof or NonexistenceProof
@@ -36,6 +64,18 @@ func NewIavlCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp
 	}
 }
 
+// NewSmtCommitmentOp returns a CommitmentOp for a proof of the given key from
+// the sparse Merkle tree of an SMT store. The proof is of the SHA-256 hash of
+// the key, which is verified by Run.
+func NewSmtCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
+	return CommitmentOp{
+		Type:  ProofOpSMTCommitment,
+		Spec:  SmtSpec,
+		Key:   key,
+		Proof: proof,
+	}
+}
+
 func NewSimpleMerkleCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
 	return CommitmentOp{
 		Type:  ProofOpSimpleMerkleCommitment,
@@ -55,8 +95,10 @@ func CommitmentOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
 		spec = ics23.IavlSpec
 	case ProofOpSimpleMerkleCommitment:
 		spec = ics23.TendermintSpec
+	case ProofOpSMTCommitment:
+		spec = SmtSpec
 	default:
-		return nil, sdkerrors.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want supported ics23 subtypes 'ProofOpIAVLCommitment' or 'ProofOpSimpleMerkleCommitment'", pop.Type)
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want supported ics23 subtypes 'ProofOpIAVLCommitment', 'ProofOpSimpleMerkleCommitment' or 'ProofOpSMTCommitment'", pop.Type)
 	}
 
 	proof := &ics23.CommitmentProof{}
@@ -93,18 +135,26 @@ func (op CommitmentOp) Run(args [][]byte) ([][]byte, error) {
 	if err != nil {
 		return nil, sdkerrors.Wrapf(ErrInvalidProof, "could not calculate root for proof: %v", err)
 	}
+
+	// SMT proofs prove the path of the key in the tree, i.e. its hash
+	key := op.Key
+	if op.Type == ProofOpSMTCommitment {
+		path := sha256.Sum256(op.Key)
+		key = path[:]
+	}
+
 	// Only support an existence proof or nonexistence proof (batch proofs currently unsupported)
 	switch len(args) {
 	case 0:
 		// Args are nil, so we verify the absence of the key.
-		absent := ics23.VerifyNonMembership(op.Spec, root, op.Proof, op.Key)
+		absent := ics23.VerifyNonMembership(op.Spec, root, op.Proof, key)
 		if !absent {
 			return nil, sdkerrors.Wrapf(ErrInvalidProof, "proof did not verify absence of key: %s", string(op.Key))
 		}
 
 	case 1:
 		// Args is length 1, verify existence of key with value args[0]
-		if !ics23.VerifyMembership(op.Spec, root, op.Proof, op.Key, args[0]) {
+		if !ics23.VerifyMembership(op.Spec, root, op.Proof, key, args[0]) {
 			return nil, sdkerrors.Wrapf(ErrInvalidProof, "proof did not verify existence of key %s with given value %x", op.Key, args[0])
 		}
 	default:
@@ -128,3 +178,180 @@ func (op CommitmentOp) ProofOp() merkle.ProofOp {
 		Data: bz,
 	}
 }
+
+// RangeOp implements merkle.ProofOperator by wrapping an ics23 batch
+// CommitmentProof which proves that a list of key-value pairs is exactly the
+// set of pairs of an IAVL tree whose keys have a given prefix, with none
+// omitted. The batch holds an existence proof for every pair, and
+// nonexistence proofs showing that no other key lies before the first pair,
+// between consecutive pairs or after the last pair within the prefix.
+type RangeOp struct {
+	Type   string
+	Spec   *ics23.ProofSpec
+	Prefix []byte
+	Proof  *ics23.CommitmentProof
+}
+
+var _ merkle.ProofOperator = RangeOp{}
+
+func NewIavlRangeOp(prefix []byte, proof *ics23.CommitmentProof) RangeOp {
+	return RangeOp{
+		Type:   ProofOpIAVLRange,
+		Spec:   ics23.IavlSpec,
+		Prefix: prefix,
+		Proof:  proof,
+	}
+}
+
+// RangeOpDecoder takes a merkle.ProofOp and attempts to decode it into a RangeOp
+// ProofOperator. The proofOp.Data is a marshalled batch CommitmentProof.
+func RangeOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
+	if pop.Type != ProofOpIAVLRange {
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want %s", pop.Type, ProofOpIAVLRange)
+	}
+
+	proof := &ics23.CommitmentProof{}
+	if err := proof.Unmarshal(pop.Data); err != nil {
+		return nil, err
+	}
+
+	return NewIavlRangeOp(pop.Key, proof), nil
+}
+
+// GetKey implements the merkle.ProofOperator interface. It returns the prefix.
+func (op RangeOp) GetKey() []byte {
+	return op.Prefix
+}
+
+// Run takes in the key-value pairs to prove as alternating keys and values, in
+// ascending key order, and returns the root of the tree wrapped in [][]byte if
+// the proof shows that they are exactly the pairs of the tree with the prefix of
+// the op. If not, it will return an error.
+func (op RangeOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args)%2 != 0 {
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "args must be key-value pairs, got: %d", len(args))
+	}
+
+	proof := ics23.Decompress(op.Proof)
+
+	batch := proof.GetBatch()
+	if batch == nil || len(batch.Entries) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidProof, "range proof must be a non-empty batch proof")
+	}
+
+	// an empty tree is proven by a single nonexistence proof without neighbors
+	if np := batch.Entries[0].GetNonexist(); len(batch.Entries) == 1 && len(args) == 0 &&
+		np != nil && np.Left == nil && np.Right == nil {
+		return [][]byte{{}}, nil
+	}
+
+	root, err := proof.Calculate()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "could not calculate root for proof: %v", err)
+	}
+
+	var prev []byte
+
+	for i := 0; i < len(args); i += 2 {
+		key, value := args[i], args[i+1]
+
+		if !bytes.HasPrefix(key, op.Prefix) {
+			return nil, sdkerrors.Wrapf(ErrInvalidProof, "key %X does not have prefix %X", key, op.Prefix)
+		}
+
+		if prev != nil && bytes.Compare(prev, key) >= 0 {
+			return nil, sdkerrors.Wrapf(ErrInvalidProof, "keys are not in ascending order: %X >= %X", prev, key)
+		}
+
+		if !ics23.VerifyMembership(op.Spec, root, proof, key, value) {
+			return nil, sdkerrors.Wrapf(ErrInvalidProof, "proof did not verify existence of key %X with given value %X", key, value)
+		}
+
+		// no key may lie between this key and the next one
+		if i+2 < len(args) && !bytes.Equal(args[i+2], successor(key)) {
+			if err := op.verifyNeighbors(root, batch, successor(key), key, args[i+2]); err != nil {
+				return nil, err
+			}
+		}
+
+		prev = key
+	}
+
+	// no key with the prefix may lie before the first key or after the last one
+	if len(args) == 0 {
+		if err := op.verifyNeighbors(root, batch, op.Prefix, nil, nil); err != nil {
+			return nil, err
+		}
+	} else {
+		if first := args[0]; !bytes.Equal(first, op.Prefix) {
+			if err := op.verifyNeighbors(root, batch, op.Prefix, nil, first); err != nil {
+				return nil, err
+			}
+		}
+
+		last := args[len(args)-2]
+		if err := op.verifyNeighbors(root, batch, successor(last), last, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return [][]byte{root}, nil
+}
+
+// verifyNeighbors verifies that the batch proves the absence of the given key,
+// and that its neighbors in the tree are the given left and right keys. A nil
+// left key is not checked, while a nil right key requires the right neighbor to
+// be missing or not to have the prefix of the op.
+func (op RangeOp) verifyNeighbors(root []byte, batch *ics23.BatchProof, key, left, right []byte) error {
+	var np *ics23.NonExistenceProof
+
+	for _, entry := range batch.Entries {
+		if nonexist := entry.GetNonexist(); nonexist != nil && bytes.Equal(nonexist.Key, key) {
+			np = nonexist
+			break
+		}
+	}
+
+	if np == nil {
+		return sdkerrors.Wrapf(ErrInvalidProof, "range proof is missing the nonexistence proof of key %X", key)
+	}
+
+	if err := np.Verify(op.Spec, root, key); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidProof, "proof did not verify absence of key %X: %v", key, err)
+	}
+
+	if left != nil && (np.Left == nil || !bytes.Equal(np.Left.Key, left)) {
+		return sdkerrors.Wrapf(ErrInvalidProof, "key %X is not the left neighbor of key %X", left, key)
+	}
+
+	switch {
+	case right != nil && (np.Right == nil || !bytes.Equal(np.Right.Key, right)):
+		return sdkerrors.Wrapf(ErrInvalidProof, "key %X is not the right neighbor of key %X", right, key)
+
+	case right == nil && np.Right != nil && bytes.HasPrefix(np.Right.Key, op.Prefix):
+		return sdkerrors.Wrapf(ErrInvalidProof, "proof omits key %X with prefix %X", np.Right.Key, op.Prefix)
+	}
+
+	return nil
+}
+
+// ProofOp implements ProofOperator interface and converts a RangeOp into a
+// merkle.ProofOp format that can later be decoded by RangeOpDecoder back into a
+// RangeOp for proof verification.
+func (op RangeOp) ProofOp() merkle.ProofOp {
+	bz, err := op.Proof.Marshal()
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return merkle.ProofOp{
+		Type: op.Type,
+		Key:  op.Prefix,
+		Data: bz,
+	}
+}
+
+// successor returns the smallest key greater than the given one.
+func successor(key []byte) []byte {
+	return append(append(make([]byte, 0, len(key)+1), key...), 0)
+}
@@ -7,6 +7,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmkv "github.com/tendermint/tendermint/libs/kv"
 	dbm "github.com/tendermint/tm-db"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 )
 
 type Store interface {
@@ -41,9 +43,15 @@ type Queryable interface {
 // MultiStore
 
 // StoreUpgrades defines a series of transformations to apply the multistore db upon load
+//
+// Stores are deleted and renamed first, then split, and their keys rewritten
+// last. Added stores must be mounted and have no data yet.
 type StoreUpgrades struct {
-	Renamed []StoreRename `json:"renamed"`
-	Deleted []string      `json:"deleted"`
+	Added    []string      `json:"added"`
+	Renamed  []StoreRename `json:"renamed"`
+	Deleted  []string      `json:"deleted"`
+	Split    []StoreSplit  `json:"split"`
+	Rewrites []KeyRewrite  `json:"-"`
 }
 
 // UpgradeInfo defines height and name of the upgrade
@@ -61,6 +69,51 @@ type StoreRename struct {
 	NewKey string `json:"new_key"`
 }
 
+// StoreSplit defines the split of a sub-store by key prefix.
+// All data of OldKey under any of the Prefixes will be moved to NewKey, keeping
+// its keys, which must not exist in NewKey yet.
+type StoreSplit struct {
+	OldKey   string   `json:"old_key"`
+	NewKey   string   `json:"new_key"`
+	Prefixes [][]byte `json:"prefixes"`
+}
+
+// KeyRewriter returns the new key and value of a key-value pair of a sub-store.
+// The pair is deleted if the new value is nil.
+type KeyRewriter func(key, value []byte) (newKey, newValue []byte, err error)
+
+// KeyRewrite defines a rewrite of the data of a sub-store under a prefix.
+// All key-value pairs of StoreKey under Prefix will be replaced by the pairs
+// returned by Rewrite, whose keys must not collide with each other nor with
+// the keys outside of the rewritten pairs.
+type KeyRewrite struct {
+	StoreKey string
+	Prefix   []byte
+	Rewrite  KeyRewriter
+}
+
+// IsEmpty returns true if there is no transformation to apply
+func (s *StoreUpgrades) IsEmpty() bool {
+	if s == nil {
+		return true
+	}
+	return len(s.Added) == 0 && len(s.Renamed) == 0 && len(s.Deleted) == 0 &&
+		len(s.Split) == 0 && len(s.Rewrites) == 0
+}
+
+// IsAdded returns true if the given key should be added
+func (s *StoreUpgrades) IsAdded(key string) bool {
+	if s == nil {
+		return false
+	}
+	for _, a := range s.Added {
+		if a == key {
+			return true
+		}
+	}
+	return false
+}
+
 // IsDeleted returns true if the given key should be deleted
 func (s *StoreUpgrades) IsDeleted(key string) bool {
 	if s == nil {
@@ -118,6 +171,15 @@ type MultiStore interface {
 	// implied that the caller should update the context when necessary between
 	// tracing operations. The modified MultiStore is returned.
 	SetTracingContext(TraceContext) MultiStore
+
+	// ListeningEnabled returns if listening is enabled for the KVStore
+	// belonging to the provided StoreKey.
+	ListeningEnabled(key StoreKey) bool
+
+	// AddListeners adds WriteListeners for the KVStore belonging to the
+	// provided StoreKey. The listeners are notified of every Set and Delete
+	// applied to that KVStore through the MultiStore.
+	AddListeners(key StoreKey, listeners []WriteListener)
 }
 
 // From MultiStore.CacheMultiStore()....
@@ -130,6 +192,7 @@ type CacheMultiStore interface {
 type CommitMultiStore interface {
 	Committer
 	MultiStore
+	snapshottypes.Snapshotter
 
 	// Mount a store of type using the given db.
 	// If db == nil, the new store will use the CommitMultiStore db.
@@ -275,6 +338,7 @@ const (
 	StoreTypeIAVL
 	StoreTypeTransient
 	StoreTypeMemory
+	StoreTypeSMT
 )
 
 func (st StoreType) String() string {
@@ -293,6 +357,9 @@ func (st StoreType) String() string {
 
 	case StoreTypeMemory:
 		return "StoreTypeMemory"
+
+	case StoreTypeSMT:
+		return "StoreTypeSMT"
 	}
 
 	return "unknown store type"
@@ -0,0 +1,1447 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/streaming/file.proto
+
+package file
+
+import (
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/store/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/tendermint/tendermint/abci/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StreamRecord is a single length-prefixed record in a block file written by the
+// file streaming service. Each record holds an ABCI message processed by the app
+// together with the state changes it caused, in the order they were applied.
+type StreamRecord struct {
+	// record is the specific type of stream record.
+	//
+	// Types that are valid to be assigned to Record:
+	//	*StreamRecord_BeginBlock
+	//	*StreamRecord_DeliverTx
+	//	*StreamRecord_EndBlock
+	Record isStreamRecord_Record `protobuf_oneof:"record"`
+}
+
+func (m *StreamRecord) Reset()         { *m = StreamRecord{} }
+func (m *StreamRecord) String() string { return proto.CompactTextString(m) }
+func (*StreamRecord) ProtoMessage()    {}
+func (*StreamRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abd024f40797fca2, []int{0}
+}
+func (m *StreamRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRecord.Merge(m, src)
+}
+func (m *StreamRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamRecord proto.InternalMessageInfo
+
+type isStreamRecord_Record interface {
+	isStreamRecord_Record()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StreamRecord_BeginBlock struct {
+	BeginBlock *BeginBlockRecord `protobuf:"bytes,1,opt,name=begin_block,json=beginBlock,proto3,oneof" json:"begin_block,omitempty"`
+}
+type StreamRecord_DeliverTx struct {
+	DeliverTx *DeliverTxRecord `protobuf:"bytes,2,opt,name=deliver_tx,json=deliverTx,proto3,oneof" json:"deliver_tx,omitempty"`
+}
+type StreamRecord_EndBlock struct {
+	EndBlock *EndBlockRecord `protobuf:"bytes,3,opt,name=end_block,json=endBlock,proto3,oneof" json:"end_block,omitempty"`
+}
+
+func (*StreamRecord_BeginBlock) isStreamRecord_Record() {}
+func (*StreamRecord_DeliverTx) isStreamRecord_Record()  {}
+func (*StreamRecord_EndBlock) isStreamRecord_Record()   {}
+
+func (m *StreamRecord) GetRecord() isStreamRecord_Record {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+func (m *StreamRecord) GetBeginBlock() *BeginBlockRecord {
+	if x, ok := m.GetRecord().(*StreamRecord_BeginBlock); ok {
+		return x.BeginBlock
+	}
+	return nil
+}
+
+func (m *StreamRecord) GetDeliverTx() *DeliverTxRecord {
+	if x, ok := m.GetRecord().(*StreamRecord_DeliverTx); ok {
+		return x.DeliverTx
+	}
+	return nil
+}
+
+func (m *StreamRecord) GetEndBlock() *EndBlockRecord {
+	if x, ok := m.GetRecord().(*StreamRecord_EndBlock); ok {
+		return x.EndBlock
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamRecord) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamRecord_BeginBlock)(nil),
+		(*StreamRecord_DeliverTx)(nil),
+		(*StreamRecord_EndBlock)(nil),
+	}
+}
+
+// BeginBlockRecord contains a BeginBlock request and response, and the state
+// changes made during BeginBlock.
+type BeginBlockRecord struct {
+	Request      types.RequestBeginBlock  `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Response     types.ResponseBeginBlock `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+	StateChanges []*types1.StoreKVPair    `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *BeginBlockRecord) Reset()         { *m = BeginBlockRecord{} }
+func (m *BeginBlockRecord) String() string { return proto.CompactTextString(m) }
+func (*BeginBlockRecord) ProtoMessage()    {}
+func (*BeginBlockRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abd024f40797fca2, []int{1}
+}
+func (m *BeginBlockRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeginBlockRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeginBlockRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeginBlockRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginBlockRecord.Merge(m, src)
+}
+func (m *BeginBlockRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeginBlockRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeginBlockRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeginBlockRecord proto.InternalMessageInfo
+
+func (m *BeginBlockRecord) GetRequest() types.RequestBeginBlock {
+	if m != nil {
+		return m.Request
+	}
+	return types.RequestBeginBlock{}
+}
+
+func (m *BeginBlockRecord) GetResponse() types.ResponseBeginBlock {
+	if m != nil {
+		return m.Response
+	}
+	return types.ResponseBeginBlock{}
+}
+
+func (m *BeginBlockRecord) GetStateChanges() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+// DeliverTxRecord contains a DeliverTx request and response, and the state
+// changes made by the transaction.
+type DeliverTxRecord struct {
+	Request      types.RequestDeliverTx  `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Response     types.ResponseDeliverTx `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+	StateChanges []*types1.StoreKVPair   `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *DeliverTxRecord) Reset()         { *m = DeliverTxRecord{} }
+func (m *DeliverTxRecord) String() string { return proto.CompactTextString(m) }
+func (*DeliverTxRecord) ProtoMessage()    {}
+func (*DeliverTxRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abd024f40797fca2, []int{2}
+}
+func (m *DeliverTxRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliverTxRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliverTxRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliverTxRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliverTxRecord.Merge(m, src)
+}
+func (m *DeliverTxRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliverTxRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliverTxRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliverTxRecord proto.InternalMessageInfo
+
+func (m *DeliverTxRecord) GetRequest() types.RequestDeliverTx {
+	if m != nil {
+		return m.Request
+	}
+	return types.RequestDeliverTx{}
+}
+
+func (m *DeliverTxRecord) GetResponse() types.ResponseDeliverTx {
+	if m != nil {
+		return m.Response
+	}
+	return types.ResponseDeliverTx{}
+}
+
+func (m *DeliverTxRecord) GetStateChanges() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+// EndBlockRecord contains an EndBlock request and response, and the state
+// changes made during EndBlock.
+type EndBlockRecord struct {
+	Request      types.RequestEndBlock  `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Response     types.ResponseEndBlock `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+	StateChanges []*types1.StoreKVPair  `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *EndBlockRecord) Reset()         { *m = EndBlockRecord{} }
+func (m *EndBlockRecord) String() string { return proto.CompactTextString(m) }
+func (*EndBlockRecord) ProtoMessage()    {}
+func (*EndBlockRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abd024f40797fca2, []int{3}
+}
+func (m *EndBlockRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndBlockRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndBlockRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndBlockRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndBlockRecord.Merge(m, src)
+}
+func (m *EndBlockRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndBlockRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndBlockRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndBlockRecord proto.InternalMessageInfo
+
+func (m *EndBlockRecord) GetRequest() types.RequestEndBlock {
+	if m != nil {
+		return m.Request
+	}
+	return types.RequestEndBlock{}
+}
+
+func (m *EndBlockRecord) GetResponse() types.ResponseEndBlock {
+	if m != nil {
+		return m.Response
+	}
+	return types.ResponseEndBlock{}
+}
+
+func (m *EndBlockRecord) GetStateChanges() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StreamRecord)(nil), "cosmos.streaming.StreamRecord")
+	proto.RegisterType((*BeginBlockRecord)(nil), "cosmos.streaming.BeginBlockRecord")
+	proto.RegisterType((*DeliverTxRecord)(nil), "cosmos.streaming.DeliverTxRecord")
+	proto.RegisterType((*EndBlockRecord)(nil), "cosmos.streaming.EndBlockRecord")
+}
+
+func init() { proto.RegisterFile("cosmos/streaming/file.proto", fileDescriptor_abd024f40797fca2) }
+
+var fileDescriptor_abd024f40797fca2 = []byte{
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd4, 0x30,
+	0x10, 0xc6, 0x63, 0x16, 0x95, 0xad, 0xb7, 0x40, 0x65, 0x71, 0x58, 0x0a, 0x0a, 0xdb, 0x1c, 0x68,
+	0x10, 0xc2, 0x91, 0xca, 0x1d, 0xa4, 0xc0, 0x42, 0xa1, 0x17, 0x94, 0x22, 0x0e, 0x5c, 0x56, 0xf9,
+	0x33, 0xa4, 0x56, 0x13, 0x7b, 0xb1, 0x5d, 0x54, 0xde, 0x82, 0xc7, 0xea, 0xb1, 0x47, 0x4e, 0x08,
+	0x6d, 0x10, 0x12, 0x6f, 0x81, 0x12, 0x67, 0xb3, 0xaa, 0xa3, 0xb6, 0x97, 0x5e, 0x9c, 0x68, 0xe6,
+	0x9b, 0xcf, 0xf3, 0x9b, 0x68, 0x82, 0x1f, 0xa4, 0x42, 0x95, 0x42, 0x05, 0x4a, 0x4b, 0x88, 0x4b,
+	0xc6, 0xf3, 0xe0, 0x0b, 0x2b, 0x80, 0xce, 0xa5, 0xd0, 0x82, 0x6c, 0x9a, 0x24, 0xed, 0x92, 0x5b,
+	0xf7, 0x72, 0x91, 0x8b, 0x26, 0x19, 0xd4, 0x6f, 0x46, 0xb7, 0xb5, 0xad, 0x81, 0x67, 0x20, 0x4b,
+	0xc6, 0x75, 0x10, 0x27, 0x29, 0x0b, 0xf4, 0xf7, 0x39, 0x28, 0x73, 0xb6, 0x92, 0x87, 0xdd, 0x3d,
+	0x42, 0x42, 0x50, 0x30, 0xa5, 0x81, 0x33, 0x9e, 0x9b, 0xac, 0xf7, 0x07, 0xe1, 0x8d, 0x83, 0xe6,
+	0x92, 0x08, 0x52, 0x21, 0x33, 0x32, 0xc5, 0xa3, 0x04, 0x72, 0xc6, 0x67, 0x49, 0x21, 0xd2, 0xa3,
+	0x31, 0x9a, 0x20, 0x7f, 0xb4, 0xeb, 0x51, 0xbb, 0x1f, 0x1a, 0xd6, 0xa2, 0xb0, 0xd6, 0x98, 0xc2,
+	0x3d, 0x27, 0xc2, 0x49, 0x17, 0x23, 0x21, 0xc6, 0x19, 0x14, 0xec, 0x1b, 0xc8, 0x99, 0x3e, 0x19,
+	0xdf, 0x68, 0x5c, 0xb6, 0xfb, 0x2e, 0xaf, 0x8d, 0xe6, 0xe3, 0x49, 0x67, 0xb2, 0x9e, 0x2d, 0x43,
+	0xe4, 0x25, 0x5e, 0x07, 0x9e, 0xb5, 0x8d, 0x0c, 0x1a, 0x8b, 0x49, 0xdf, 0x62, 0xca, 0xb3, 0xf3,
+	0x6d, 0x0c, 0xa1, 0x8d, 0x84, 0x43, 0xbc, 0x26, 0x9b, 0xa8, 0xf7, 0x0f, 0xe1, 0x4d, 0xbb, 0x63,
+	0xb2, 0x87, 0x6f, 0x49, 0xf8, 0x7a, 0x0c, 0x4a, 0xb7, 0x98, 0x3e, 0x5d, 0x8d, 0x93, 0xd6, 0xe3,
+	0xa4, 0x66, 0x90, 0x91, 0x51, 0xad, 0x0c, 0xc2, 0x9b, 0xa7, 0xbf, 0x1e, 0x39, 0xd1, 0xb2, 0x9c,
+	0xec, 0xe3, 0xa1, 0x04, 0x35, 0x17, 0x5c, 0x41, 0xcb, 0xfa, 0xe4, 0x42, 0x2b, 0x23, 0xeb, 0x79,
+	0x75, 0x06, 0xe4, 0x05, 0xbe, 0xad, 0x74, 0xac, 0x61, 0x96, 0x1e, 0xc6, 0x3c, 0x07, 0x35, 0x1e,
+	0x4c, 0x06, 0xfe, 0x68, 0xf7, 0xfe, 0x0a, 0x5d, 0x48, 0xa0, 0x07, 0xf5, 0xb9, 0xff, 0xe9, 0x43,
+	0xcc, 0x64, 0xb4, 0xd1, 0xe8, 0x5f, 0x19, 0xb9, 0xf7, 0x17, 0xe1, 0xbb, 0xd6, 0x5c, 0xc9, 0x5b,
+	0x1b, 0x75, 0xe7, 0x72, 0xd4, 0xae, 0xde, 0x26, 0x7d, 0xdf, 0x23, 0xf5, 0xaf, 0x20, 0xb5, 0xad,
+	0xae, 0x0f, 0xb4, 0x42, 0xf8, 0xce, 0xf9, 0xaf, 0x4f, 0xde, 0xd8, 0x9c, 0x8f, 0x2f, 0xe7, 0x5c,
+	0x96, 0xdb, 0x98, 0xef, 0x7a, 0x98, 0x3b, 0x57, 0x60, 0x5a, 0x4e, 0xd7, 0x46, 0x19, 0x4e, 0x4f,
+	0x17, 0x2e, 0x3a, 0x5b, 0xb8, 0xe8, 0xf7, 0xc2, 0x45, 0x3f, 0x2a, 0xd7, 0x39, 0xab, 0x5c, 0xe7,
+	0x67, 0xe5, 0x3a, 0x9f, 0x9f, 0xe6, 0x4c, 0x1f, 0x1e, 0x27, 0x34, 0x15, 0x65, 0xd0, 0x2e, 0xb9,
+	0x79, 0x3c, 0x53, 0xd9, 0x91, 0xf5, 0x5f, 0x49, 0xd6, 0x9a, 0x7d, 0x7f, 0xfe, 0x7f, 0x00, 0xb9,
+	0xde, 0x09, 0x68, 0x77, 0x04, 0x00, 0x00,
+}
+
+func (m *StreamRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		{
+			size := m.Record.Size()
+			i -= size
+			if _, err := m.Record.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamRecord_BeginBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamRecord_BeginBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BeginBlock != nil {
+		{
+			size, err := m.BeginBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFile(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamRecord_DeliverTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamRecord_DeliverTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeliverTx != nil {
+		{
+			size, err := m.DeliverTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFile(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamRecord_EndBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamRecord_EndBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.EndBlock != nil {
+		{
+			size, err := m.EndBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFile(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BeginBlockRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeginBlockRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeginBlockRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFile(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFile(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliverTxRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliverTxRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliverTxRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFile(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFile(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EndBlockRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndBlockRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndBlockRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFile(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFile(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFile(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFile(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		n += m.Record.Size()
+	}
+	return n
+}
+
+func (m *StreamRecord_BeginBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginBlock != nil {
+		l = m.BeginBlock.Size()
+		n += 1 + l + sovFile(uint64(l))
+	}
+	return n
+}
+func (m *StreamRecord_DeliverTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeliverTx != nil {
+		l = m.DeliverTx.Size()
+		n += 1 + l + sovFile(uint64(l))
+	}
+	return n
+}
+func (m *StreamRecord_EndBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EndBlock != nil {
+		l = m.EndBlock.Size()
+		n += 1 + l + sovFile(uint64(l))
+	}
+	return n
+}
+func (m *BeginBlockRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovFile(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovFile(uint64(l))
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovFile(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeliverTxRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovFile(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovFile(uint64(l))
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovFile(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EndBlockRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovFile(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovFile(uint64(l))
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovFile(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFile(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFile(x uint64) (n int) {
+	return sovFile(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BeginBlockRecord{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Record = &StreamRecord_BeginBlock{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DeliverTxRecord{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Record = &StreamRecord_DeliverTx{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EndBlockRecord{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Record = &StreamRecord_EndBlock{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFile
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginBlockRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginBlockRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginBlockRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types1.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFile
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeliverTxRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliverTxRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliverTxRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types1.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFile
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndBlockRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndBlockRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndBlockRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types1.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFile
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFile(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFile
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFile
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFile
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFile
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFile        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFile          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFile = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,204 @@
+/*
+Package file implements a baseapp.StreamingService which writes the ABCI
+messages processed by the app, together with the state changes they caused, to
+one file per block.
+
+Each block file is named "block-<height>" (optionally prefixed with
+"<prefix>-") and holds a sequence of length-prefixed protobuf encoded
+StreamRecords: one for BeginBlock, one per DeliverTx in execution order and one
+for EndBlock. A block file is complete once it contains its EndBlock record.
+*/
+package file
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ baseapp.StreamingService = (*StreamingService)(nil)
+	_ sdk.WriteListener        = (*StreamingService)(nil)
+)
+
+// StreamingService is a baseapp.StreamingService which writes block files to a
+// directory.
+type StreamingService struct {
+	listeners  map[sdk.StoreKey][]sdk.WriteListener
+	writeDir   string
+	filePrefix string
+
+	mtx          sync.Mutex
+	stateChanges []*types.StoreKVPair // state changes since the last ABCI message
+	file         *os.File             // file of the current block, nil between blocks
+	height       int64                // height of the current block
+}
+
+// NewStreamingService creates a new StreamingService which streams the state
+// changes of the KVStores belonging to the given store keys into block files in
+// writeDir.
+func NewStreamingService(writeDir, filePrefix string, storeKeys []sdk.StoreKey) (*StreamingService, error) {
+	if writeDir == "" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "streaming directory not given")
+	}
+
+	if err := os.MkdirAll(writeDir, 0755); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to create streaming directory %q", writeDir)
+	}
+
+	s := &StreamingService{
+		listeners:  make(map[sdk.StoreKey][]sdk.WriteListener, len(storeKeys)),
+		writeDir:   writeDir,
+		filePrefix: filePrefix,
+	}
+
+	for _, key := range storeKeys {
+		s.listeners[key] = []sdk.WriteListener{s}
+	}
+
+	return s, nil
+}
+
+// Listeners implements the baseapp.StreamingService interface.
+func (s *StreamingService) Listeners() map[sdk.StoreKey][]sdk.WriteListener {
+	return s.listeners
+}
+
+// OnWrite implements the WriteListener interface. It caches the state change
+// until the ABCI message that caused it is written out.
+func (s *StreamingService) OnWrite(storeKey sdk.StoreKey, key []byte, value []byte, delete bool) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.stateChanges = append(s.stateChanges, &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+
+	return nil
+}
+
+// ListenBeginBlock implements the baseapp.ABCIListener interface. It creates
+// the file of the new block and writes the BeginBlock record to it.
+func (s *StreamingService) ListenBeginBlock(
+	ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock,
+) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.file != nil {
+		s.file.Close()
+		s.file = nil
+	}
+
+	path := s.BlockFilePath(req.Header.Height)
+
+	file, err := os.Create(path)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to create block file %q", path)
+	}
+
+	s.file = file
+	s.height = req.Header.Height
+
+	return s.writeRecord(&StreamRecord{
+		Record: &StreamRecord_BeginBlock{BeginBlock: &BeginBlockRecord{
+			Request:      req,
+			Response:     res,
+			StateChanges: s.stateChanges,
+		}},
+	})
+}
+
+// ListenDeliverTx implements the baseapp.ABCIListener interface. It writes a
+// DeliverTx record to the file of the current block.
+func (s *StreamingService) ListenDeliverTx(
+	ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx,
+) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.writeRecord(&StreamRecord{
+		Record: &StreamRecord_DeliverTx{DeliverTx: &DeliverTxRecord{
+			Request:      req,
+			Response:     res,
+			StateChanges: s.stateChanges,
+		}},
+	})
+}
+
+// ListenEndBlock implements the baseapp.ABCIListener interface. It writes the
+// EndBlock record to the file of the current block and closes it.
+func (s *StreamingService) ListenEndBlock(
+	ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock,
+) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	err := s.writeRecord(&StreamRecord{
+		Record: &StreamRecord_EndBlock{EndBlock: &EndBlockRecord{
+			Request:      req,
+			Response:     res,
+			StateChanges: s.stateChanges,
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.file.Close()
+	s.file = nil
+
+	return sdkerrors.Wrapf(err, "failed to close block file for height %v", s.height)
+}
+
+// Close implements the io.Closer interface. It closes the file of the current
+// block, if any.
+func (s *StreamingService) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+
+	err := s.file.Close()
+	s.file = nil
+
+	return err
+}
+
+// BlockFilePath returns the path of the file for the block at the given height.
+func (s *StreamingService) BlockFilePath(height int64) string {
+	name := fmt.Sprintf("block-%d", height)
+	if s.filePrefix != "" {
+		name = fmt.Sprintf("%s-%s", s.filePrefix, name)
+	}
+
+	return filepath.Join(s.writeDir, name)
+}
+
+// writeRecord writes a length-prefixed record to the file of the current block
+// and resets the cached state changes. It must be called with the mutex held.
+func (s *StreamingService) writeRecord(record *StreamRecord) error {
+	s.stateChanges = nil
+
+	if s.file == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "no block in progress")
+	}
+
+	err := protoio.NewDelimitedWriter(s.file).WriteMsg(record)
+
+	return sdkerrors.Wrapf(err, "failed to write record for height %v", s.height)
+}
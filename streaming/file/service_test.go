@@ -0,0 +1,119 @@
+package file_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/streaming/file"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+)
+
+func setupService(t *testing.T, prefix string) (*file.StreamingService, func()) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+
+	s, err := file.NewStreamingService(dir, prefix, []sdk.StoreKey{mockStoreKey1, mockStoreKey2})
+	require.NoError(t, err)
+
+	return s, func() {
+		s.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func readRecords(t *testing.T, path string) []*file.StreamRecord {
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	reader := protoio.NewDelimitedReader(bytes.NewReader(bz), 1<<20)
+	records := []*file.StreamRecord{}
+
+	for {
+		record := &file.StreamRecord{}
+		err := reader.ReadMsg(record)
+		if err == io.EOF {
+			return records
+		}
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+}
+
+func TestNewStreamingService(t *testing.T) {
+	_, err := file.NewStreamingService("", "", nil)
+	require.Error(t, err)
+
+	s, teardown := setupService(t, "")
+	defer teardown()
+
+	listeners := s.Listeners()
+	require.Len(t, listeners, 2)
+	require.Equal(t, []sdk.WriteListener{s}, listeners[mockStoreKey1])
+	require.Equal(t, []sdk.WriteListener{s}, listeners[mockStoreKey2])
+}
+
+func TestStreamingService_Block(t *testing.T) {
+	s, teardown := setupService(t, "node")
+	defer teardown()
+
+	ctx := sdk.Context{}
+
+	beginReq := abci.RequestBeginBlock{Header: abci.Header{Height: 7}}
+	beginRes := abci.ResponseBeginBlock{Events: []abci.Event{{Type: "begin"}}}
+	deliverReq := abci.RequestDeliverTx{Tx: []byte("tx")}
+	deliverRes := abci.ResponseDeliverTx{Code: 1, Log: "failed"}
+	endReq := abci.RequestEndBlock{Height: 7}
+	endRes := abci.ResponseEndBlock{Events: []abci.Event{{Type: "end"}}}
+
+	// DeliverTx outside of a block should fail
+	require.Error(t, s.ListenDeliverTx(ctx, deliverReq, deliverRes))
+
+	require.NoError(t, s.OnWrite(mockStoreKey1, []byte("k1"), []byte("v1"), false))
+	require.NoError(t, s.ListenBeginBlock(ctx, beginReq, beginRes))
+
+	require.NoError(t, s.OnWrite(mockStoreKey2, []byte("k2"), []byte("v2"), false))
+	require.NoError(t, s.OnWrite(mockStoreKey1, []byte("k1"), nil, true))
+	require.NoError(t, s.ListenDeliverTx(ctx, deliverReq, deliverRes))
+
+	require.NoError(t, s.ListenEndBlock(ctx, endReq, endRes))
+
+	require.Contains(t, s.BlockFilePath(7), "node-block-7")
+	records := readRecords(t, s.BlockFilePath(7))
+	require.Equal(t, []*file.StreamRecord{
+		{Record: &file.StreamRecord_BeginBlock{BeginBlock: &file.BeginBlockRecord{
+			Request:  beginReq,
+			Response: beginRes,
+			StateChanges: []*types.StoreKVPair{
+				{StoreKey: "mockStore1", Key: []byte("k1"), Value: []byte("v1")},
+			},
+		}}},
+		{Record: &file.StreamRecord_DeliverTx{DeliverTx: &file.DeliverTxRecord{
+			Request:  deliverReq,
+			Response: deliverRes,
+			StateChanges: []*types.StoreKVPair{
+				{StoreKey: "mockStore2", Key: []byte("k2"), Value: []byte("v2")},
+				{StoreKey: "mockStore1", Delete: true, Key: []byte("k1")},
+			},
+		}}},
+		{Record: &file.StreamRecord_EndBlock{EndBlock: &file.EndBlockRecord{
+			Request:  endReq,
+			Response: endRes,
+		}}},
+	}, records)
+
+	// EndBlock closes the block
+	require.Error(t, s.ListenDeliverTx(ctx, deliverReq, deliverRes))
+}
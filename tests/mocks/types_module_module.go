@@ -490,6 +490,18 @@ func (mr *MockAppModuleMockRecorder) RegisterQueryService(arg0 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterQueryService", reflect.TypeOf((*MockAppModule)(nil).RegisterQueryService), arg0)
 }
 
+// RegisterMsgService mocks base method
+func (m *MockAppModule) RegisterMsgService(arg0 grpc.Server) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterMsgService", arg0)
+}
+
+// RegisterMsgService indicates an expected call of RegisterMsgService
+func (mr *MockAppModuleMockRecorder) RegisterMsgService(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterMsgService", reflect.TypeOf((*MockAppModule)(nil).RegisterMsgService), arg0)
+}
+
 // BeginBlock mocks base method
 func (m *MockAppModule) BeginBlock(arg0 types.Context, arg1 types0.RequestBeginBlock) {
 	m.ctrl.T.Helper()
@@ -35,6 +35,9 @@ type Context struct {
 	minGasPrice   DecCoins
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
+	priority      int64  // mempool priority of the transaction, set in CheckTx
+	sender        string // mempool sender key of the transaction, set in CheckTx
+	gasTracker    StoreGasTracker
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -55,6 +58,9 @@ func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) Priority() int64             { return c.priority }
+func (c Context) Sender() string              { return c.sender }
+func (c Context) GasTracker() StoreGasTracker { return c.gasTracker }
 
 // clone the header before returning
 func (c Context) BlockHeader() abci.Header {
@@ -195,6 +201,27 @@ func (c Context) WithEventManager(em *EventManager) Context {
 	return c
 }
 
+// WithPriority returns a Context with an updated mempool priority of the
+// transaction. Transactions with a higher priority are preferred by the mempool.
+func (c Context) WithPriority(priority int64) Context {
+	c.priority = priority
+	return c
+}
+
+// WithSender returns a Context with an updated mempool sender key of the
+// transaction, which the mempool uses to group transactions by sender.
+func (c Context) WithSender(sender string) Context {
+	c.sender = sender
+	return c
+}
+
+// WithGasTracker returns a Context with an updated StoreGasTracker, which
+// records the gas consumed by the KVStores of the Context by store key.
+func (c Context) WithGasTracker(tracker StoreGasTracker) Context {
+	c.gasTracker = tracker
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
@@ -225,12 +252,22 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.storeGasMeter(key), stypes.KVGasConfig())
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.TransientGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.storeGasMeter(key), stypes.TransientGasConfig())
+}
+
+// storeGasMeter returns the gas meter of the KVStore with the given key, which
+// reports the gas consumed to the StoreGasTracker of the Context, if any.
+func (c Context) storeGasMeter(key StoreKey) GasMeter {
+	if c.gasTracker == nil {
+		return c.GasMeter()
+	}
+
+	return trackedGasMeter{GasMeter: c.GasMeter(), key: key, tracker: c.gasTracker}
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
@@ -133,11 +133,13 @@ func toBytes(i interface{}) []byte {
 // Common event types and attribute keys
 var (
 	EventTypeMessage = "message"
+	EventTypeMempool = "mempool"
 
-	AttributeKeyAction = "action"
-	AttributeKeyModule = "module"
-	AttributeKeySender = "sender"
-	AttributeKeyAmount = "amount"
+	AttributeKeyAction   = "action"
+	AttributeKeyModule   = "module"
+	AttributeKeySender   = "sender"
+	AttributeKeyAmount   = "amount"
+	AttributeKeyPriority = "priority"
 )
 
 type (
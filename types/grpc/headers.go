@@ -0,0 +1,9 @@
+package grpc
+
+const (
+	// GRPCBlockHeightHeader is the gRPC header for the block height. Clients set
+	// it in the request metadata to query the state at a given height, and the
+	// server sets it in the response header to the height the query was served
+	// at.
+	GRPCBlockHeightHeader = "x-cosmos-block-height"
+)
@@ -7,6 +7,12 @@ type Handler func(ctx Context, msg Msg) (*Result, error)
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, err error)
 
+// PostHandler is run after the messages of a transaction were executed, whether
+// they succeeded or not, e.g. to refund the fees for unused gas. It gets the gas
+// wanted by the transaction and the gas it used so far.
+// If newCtx.IsZero(), ctx is used instead.
+type PostHandler func(ctx Context, tx Tx, simulate, success bool, gasWanted, gasUsed uint64) (newCtx Context, err error)
+
 // AnteDecorator wraps the next AnteHandler to perform custom pre- and post-processing.
 type AnteDecorator interface {
 	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, err error)
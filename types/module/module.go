@@ -154,6 +154,8 @@ type AppModule interface {
 	NewQuerierHandler() sdk.Querier
 	// RegisterQueryService allows a module to register a gRPC query service
 	RegisterQueryService(grpc.Server)
+	// RegisterMsgService allows a module to register a protobuf Msg service
+	RegisterMsgService(grpc.Server)
 
 	// ABCI
 	BeginBlock(sdk.Context, abci.RequestBeginBlock)
@@ -188,6 +190,8 @@ func (gam GenesisOnlyAppModule) NewQuerierHandler() sdk.Querier { return nil }
 
 func (gam GenesisOnlyAppModule) RegisterQueryService(grpc.Server) {}
 
+func (gam GenesisOnlyAppModule) RegisterMsgService(grpc.Server) {}
+
 // BeginBlock returns an empty module begin-block
 func (gam GenesisOnlyAppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
 
@@ -273,6 +277,13 @@ func (m *Manager) RegisterQueryServices(grpcRouter grpc.Server) {
 	}
 }
 
+// RegisterMsgServices registers all module Msg services
+func (m *Manager) RegisterMsgServices(msgServiceRouter grpc.Server) {
+	for _, module := range m.Modules {
+		module.RegisterMsgService(msgServiceRouter)
+	}
+}
+
 // InitGenesis performs init genesis functionality for modules
 func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
 	var validatorUpdates []abci.ValidatorUpdate
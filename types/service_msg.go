@@ -0,0 +1,105 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// MsgRequest is the interface a request of a protobuf Msg service method must
+// fulfill.
+type MsgRequest interface {
+	proto.Message
+
+	// ValidateBasic does a simple validation check that
+	// doesn't require access to any other information.
+	ValidateBasic() error
+
+	// Signers returns the addrs of signers that must sign.
+	// CONTRACT: All signatures must be present to be valid.
+	// CONTRACT: Returns addrs in some deterministic order.
+	GetSigners() []AccAddress
+}
+
+// ServiceMsg is a Msg which is routed to a protobuf Msg service method by its
+// fully qualified method name, e.g. "/cosmos.bank.Msg/Send".
+type ServiceMsg struct {
+	// MethodName is the fully qualified name of the Msg service method.
+	MethodName string
+	// Request is the request of the Msg service method.
+	Request MsgRequest
+}
+
+var _ Msg = ServiceMsg{}
+
+// IsServiceMsg returns true if the given type URL is the fully qualified name of
+// a Msg service method rather than the name of a message type, i.e. if it has
+// the form "/<service>/<method>".
+func IsServiceMsg(typeURL string) bool {
+	return strings.Count(typeURL, "/") >= 2
+}
+
+// Reset implements the proto.Message interface.
+func (msg ServiceMsg) Reset() {}
+
+// String implements the proto.Message interface.
+func (msg ServiceMsg) String() string { return "ServiceMsg" }
+
+// ProtoMessage implements the proto.Message interface.
+func (msg ServiceMsg) ProtoMessage() {}
+
+// Route implements the Msg interface. It returns the method name, as
+// ServiceMsgs are not routed by the legacy Router.
+func (msg ServiceMsg) Route() string { return msg.MethodName }
+
+// Type implements the Msg interface. It returns the method name.
+func (msg ServiceMsg) Type() string { return msg.MethodName }
+
+// ValidateBasic implements the Msg interface.
+func (msg ServiceMsg) ValidateBasic() error {
+	if msg.Request == nil {
+		return fmt.Errorf("no request for Msg service method %s", msg.MethodName)
+	}
+
+	return msg.Request.ValidateBasic()
+}
+
+// GetSignBytes implements the Msg interface. It panics, as ServiceMsgs are only
+// supported by sign modes which don't use legacy sign bytes.
+func (msg ServiceMsg) GetSignBytes() []byte {
+	panic("ServiceMsg does not have a legacy sign bytes representation")
+}
+
+// GetSigners implements the Msg interface.
+func (msg ServiceMsg) GetSigners() []AccAddress {
+	return msg.Request.GetSigners()
+}
+
+// WrapServiceResult wraps the response of a Msg service method into a Result,
+// with the proto-marshaled response as data and the events emitted to the
+// context's event manager.
+func WrapServiceResult(ctx Context, res proto.Message, err error) (*Result, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	if res != nil {
+		data, err = proto.Marshal(res)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var events []abci.Event
+	if evtMgr := ctx.EventManager(); evtMgr != nil {
+		events = evtMgr.ABCIEvents()
+	}
+
+	return &Result{
+		Data:   data,
+		Events: events,
+	}, nil
+}
@@ -71,6 +71,7 @@ const (
 	StoreTypeIAVL      = types.StoreTypeIAVL
 	StoreTypeTransient = types.StoreTypeTransient
 	StoreTypeMemory    = types.StoreTypeMemory
+	StoreTypeSMT       = types.StoreTypeSMT
 )
 
 type (
@@ -150,6 +151,10 @@ type KVPair = types.KVPair
 // every trace operation.
 type TraceContext = types.TraceContext
 
+// WriteListener defines an interface for listening to the state changes
+// (Sets and Deletes) of a KVStore.
+type WriteListener = types.WriteListener
+
 // --------------------------------------
 
 type (
@@ -170,3 +175,23 @@ type (
 func NewInfiniteGasMeter() GasMeter {
 	return types.NewInfiniteGasMeter()
 }
+
+// StoreGasTracker records the gas consumed by KVStore accesses, by store key.
+type StoreGasTracker interface {
+	TrackStoreGas(key StoreKey, amount Gas)
+}
+
+// trackedGasMeter is a GasMeter which reports the gas consumed through it to a
+// StoreGasTracker under the key of the store it meters.
+type trackedGasMeter struct {
+	GasMeter
+	key     StoreKey
+	tracker StoreGasTracker
+}
+
+// ConsumeGas implements the GasMeter interface. The gas is reported before it is
+// consumed, as consuming it may panic once the meter runs out of gas.
+func (m trackedGasMeter) ConsumeGas(amount Gas, descriptor string) {
+	m.tracker.TrackStoreGas(m.key, amount)
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
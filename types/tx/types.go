@@ -18,6 +18,18 @@ func (m *Tx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (m *TxBody) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for _, any := range m.Messages {
+		// the requests of Msg service methods are packed with the method name
+		// as type URL
+		if sdk.IsServiceMsg(any.TypeUrl) {
+			var req sdk.MsgRequest
+			err := unpacker.UnpackAny(any, &req)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		var msg sdk.Msg
 		err := unpacker.UnpackAny(any, &msg)
 		if err != nil {
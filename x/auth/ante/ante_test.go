@@ -780,20 +780,25 @@ func TestAnteHandlerReCheck(t *testing.T) {
 
 	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
 	tx := types.NewTestTxWithMemo(ctx, msgs, privs, accnums, seqs, fee, "thisisatestmemo")
+	txBytes, err := json.Marshal(tx)
+	require.Nil(t, err, "Error marshalling tx: %v", err)
+	ctx = ctx.WithTxBytes(txBytes)
+
+	// check the tx first, so that its signature is verified for the current sequence
+	checkCtx, _ := ctx.WithIsReCheckTx(false).CacheContext()
+	_, err = antehandler(checkCtx, tx, false)
+	require.Nil(t, err, "AnteHandler errored on check unexpectedly: %v", err)
 
-	// make signature array empty which would normally cause ValidateBasicDecorator and SigVerificationDecorator fail
-	// since these decorators don't run on recheck, the tx should pass the antehandler
+	// corrupt the signature which would normally cause SigVerificationDecorator to fail
+	// since the sequence is unchanged, it is not verified again and the tx should pass the antehandler
 	stdTx := tx.(types.StdTx)
-	stdTx.Signatures = []types.StdSignature{}
+	stdTx.Signatures = []types.StdSignature{stdTx.Signatures[0]}
+	stdTx.Signatures[0].Signature = append([]byte{}, stdTx.Signatures[0].Signature...)
+	stdTx.Signatures[0].Signature[0]++
 
-	_, err := antehandler(ctx, stdTx, false)
+	_, err = antehandler(ctx, stdTx, false)
 	require.Nil(t, err, "AnteHandler errored on recheck unexpectedly: %v", err)
 
-	tx = types.NewTestTxWithMemo(ctx, msgs, privs, accnums, seqs, fee, "thisisatestmemo")
-	txBytes, err := json.Marshal(tx)
-	require.Nil(t, err, "Error marshalling tx: %v", err)
-	ctx = ctx.WithTxBytes(txBytes)
-
 	// require that state machine param-dependent checking is still run on recheck since parameters can change between check and recheck
 	testCases := []struct {
 		name   string
@@ -2,6 +2,7 @@ package ante
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -17,6 +18,8 @@ var (
 // If fee is too low, decorator returns error and tx is rejected from mempool.
 // Note this only applies when ctx.CheckTx = true
 // If fee is high enough or not CheckTx, then call next AnteHandler
+// In CheckTx, the decorator also sets the mempool priority of the tx to its fee
+// per gas, and its mempool sender to the fee payer.
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
 type MempoolFeeDecorator struct{}
 
@@ -53,11 +56,39 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 			}
 		}
+
+		ctx = ctx.WithPriority(getTxPriority(feeCoins, gas)).WithSender(feeTx.FeePayer().String())
 	}
 
 	return next(ctx, tx, simulate)
 }
 
+// getTxPriority returns the priority of a tx with the given fee and gas limit,
+// which is the lowest fee per gas amongst the fee denominations. Amounts which
+// overflow are capped.
+func getTxPriority(fee sdk.Coins, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	var priority int64
+
+	for i, c := range fee {
+		p := int64(math.MaxInt64)
+
+		gasPrice := c.Amount.Quo(sdk.NewIntFromUint64(gas))
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+
+		if i == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
@@ -60,6 +60,21 @@ func TestEnsureMempoolFees(t *testing.T) {
 
 	_, err = antehandler(ctx, tx, false)
 	require.Nil(t, err, "Decorator should not have errored on fee higher than local gasPrice")
+
+	// the mempool priority is the lowest fee per gas, and the sender the fee payer
+	fee = types.NewStdFee(1000, sdk.NewCoins(sdk.NewInt64Coin("atom", 5000), sdk.NewInt64Coin("stake", 3000)))
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	newCtx, err := antehandler(ctx, tx, false)
+	require.Nil(t, err)
+	require.Equal(t, int64(3), newCtx.Priority())
+	require.Equal(t, addr1.String(), newCtx.Sender())
+
+	// neither is set in DeliverTx
+	newCtx, err = antehandler(ctx.WithIsCheckTx(false), tx, false)
+	require.Nil(t, err)
+	require.Zero(t, newCtx.Priority())
+	require.Empty(t, newCtx.Sender())
 }
 
 func TestDeductFees(t *testing.T) {
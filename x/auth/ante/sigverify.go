@@ -9,8 +9,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +21,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// verifiedSigCacheSize is the number of signatures verified in CheckTx which
+// are remembered, so that they are not verified again on ReCheckTx.
+const verifiedSigCacheSize = 10000
+
 var (
 	// simulation signature values used to estimate gas consumption
 	simSecp256k1Pubkey secp256k1.PubKeySecp256k1
@@ -159,23 +166,30 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
+//
+// Signatures verified in CheckTx are remembered. On ReCheckTx, a signature is
+// only verified again if its signer data, e.g. the account sequence, changed
+// since.
 type SigVerificationDecorator struct {
 	ak              AccountKeeper
 	signModeHandler authsigning.SignModeHandler
+	verified        *lru.Cache
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.SignModeHandler) SigVerificationDecorator {
+	verified, err := lru.New(verifiedSigCacheSize)
+	if err != nil {
+		panic(err)
+	}
+
 	return SigVerificationDecorator{
 		ak:              ak,
 		signModeHandler: signModeHandler,
+		verified:        verified,
 	}
 }
 
 func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	// no need to verify signatures on recheck tx
-	if ctx.IsReCheckTx() {
-		return next(ctx, tx, simulate)
-	}
 	sigTx, ok := tx.(authsigning.SigVerifiableTx)
 	if !ok {
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
@@ -226,18 +240,42 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 
 		if !simulate {
+			// the transaction bytes identify the signature, so it can only be
+			// remembered when they are known
+			var cacheKey string
+			if ctx.IsCheckTx() && len(ctx.TxBytes()) != 0 {
+				cacheKey = verifiedSigKey(ctx.TxBytes(), i, pubKey, signerData)
+			}
+
+			// no need to verify a signature again on recheck tx if it was verified
+			// for the same signer data
+			if ctx.IsReCheckTx() && cacheKey != "" && svd.verified.Contains(cacheKey) {
+				continue
+			}
+
 			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
 			if err != nil {
 				return ctx, sdkerrors.Wrapf(
 					sdkerrors.ErrUnauthorized,
 					"signature verification failed; verify correct account sequence (%d) and chain-id (%s)", signerAccs[i].GetSequence(), ctx.ChainID())
 			}
+
+			if cacheKey != "" {
+				svd.verified.Add(cacheKey, struct{}{})
+			}
 		}
 	}
 
 	return next(ctx, tx, simulate)
 }
 
+// verifiedSigKey returns the key of a verified signature, given the bytes of
+// the transaction, the index of the signature and the data it was verified with.
+func verifiedSigKey(txBytes []byte, index int, pubKey crypto.PubKey, signerData authsigning.SignerData) string {
+	return fmt.Sprintf("%X/%d/%X/%s/%d/%d", tmhash.Sum(txBytes), index, pubKey.Bytes(),
+		signerData.ChainID, signerData.AccountNumber, signerData.AccountSequence)
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is no need to execute IncrementSequenceDecorator on RecheckTX since
@@ -156,7 +156,9 @@ func TestSigVerification(t *testing.T) {
 		{"wrong accnums", []crypto.PrivKey{priv1, priv2, priv3}, []uint64{7, 8, 9}, []uint64{0, 0, 0}, false, true},
 		{"wrong sequences", []crypto.PrivKey{priv1, priv2, priv3}, []uint64{0, 1, 2}, []uint64{3, 4, 5}, false, true},
 		{"valid tx", []crypto.PrivKey{priv1, priv2, priv3}, []uint64{0, 1, 2}, []uint64{0, 0, 0}, false, false},
-		{"no err on recheck", []crypto.PrivKey{}, []uint64{}, []uint64{}, true, false},
+		{"no signers on recheck", []crypto.PrivKey{}, []uint64{}, []uint64{}, true, true},
+		{"valid tx on recheck", []crypto.PrivKey{priv1, priv2, priv3}, []uint64{0, 1, 2}, []uint64{0, 0, 0}, true, false},
+		{"wrong sequences on recheck", []crypto.PrivKey{priv1, priv2, priv3}, []uint64{0, 1, 2}, []uint64{3, 4, 5}, true, true},
 	}
 	for i, tc := range testCases {
 		ctx = ctx.WithIsReCheckTx(tc.recheck)
@@ -172,6 +174,53 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+func TestSigVerificationReCheck(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+
+	priv, _, addr := types.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetAccountNumber(0))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	msgs := []sdk.Msg{testdata.NewTestMsg(addr)}
+	tx := types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv}, []uint64{0}, []uint64{0}, types.NewTestStdFee())
+	ctx = ctx.WithTxBytes([]byte("txbytes"))
+
+	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper, types.LegacyAminoJSONHandler{})
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	// corrupt the signature of a copy of the tx
+	invalidTx := tx.(types.StdTx)
+	invalidTx.Signatures = []types.StdSignature{invalidTx.Signatures[0]}
+	invalidTx.Signatures[0].Signature = append([]byte{}, invalidTx.Signatures[0].Signature...)
+	invalidTx.Signatures[0].Signature[0]++
+
+	// the invalid signature is verified on recheck if the tx was not checked before
+	_, err := antehandler(ctx.WithIsReCheckTx(true), invalidTx, false)
+	require.Error(t, err)
+
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// once checked, the signature is not verified again while the sequence is unchanged
+	_, err = antehandler(ctx.WithIsReCheckTx(true), invalidTx, false)
+	require.NoError(t, err)
+
+	// but it is once the sequence changed
+	acc = app.AccountKeeper.GetAccount(ctx, addr)
+	require.NoError(t, acc.SetSequence(1))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	_, err = antehandler(ctx.WithIsReCheckTx(true), invalidTx, false)
+	require.Error(t, err)
+
+	_, err = antehandler(ctx.WithIsReCheckTx(true), tx, false)
+	require.Error(t, err)
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
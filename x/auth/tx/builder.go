@@ -64,6 +64,15 @@ func (t *builder) GetMsgs() []sdk.Msg {
 	anys := t.tx.Body.Messages
 	res := make([]sdk.Msg, len(anys))
 	for i, any := range anys {
+		if sdk.IsServiceMsg(any.TypeUrl) {
+			res[i] = sdk.ServiceMsg{
+				MethodName: any.TypeUrl,
+				Request:    any.GetCachedValue().(sdk.MsgRequest),
+			}
+
+			continue
+		}
+
 		msg := any.GetCachedValue().(sdk.Msg)
 		res[i] = msg
 	}
@@ -232,6 +241,20 @@ func (t *builder) SetMsgs(msgs ...sdk.Msg) error {
 	anys := make([]*codectypes.Any, len(msgs))
 
 	for i, msg := range msgs {
+		// the request of a Msg service method is packed with the method name as
+		// type URL
+		if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+			any, err := codectypes.NewAnyWithValue(svcMsg.Request)
+			if err != nil {
+				return err
+			}
+
+			any.TypeUrl = svcMsg.MethodName
+			anys[i] = any
+
+			continue
+		}
+
 		var err error
 		anys[i], err = codectypes.NewAnyWithValue(msg)
 		if err != nil {
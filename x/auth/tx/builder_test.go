@@ -232,3 +232,30 @@ func TestBuilderValidateBasic(t *testing.T) {
 	err = bldr.ValidateBasic()
 	require.Error(t, err)
 }
+
+func TestBuilderServiceMsgs(t *testing.T) {
+	_, _, addr := authtypes.KeyTestPubAddr()
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(interfaceRegistry)
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	interfaceRegistry.RegisterCustomTypeURL((*sdk.MsgRequest)(nil), "/testdata.Msg/Test", &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	svcMsg := sdk.ServiceMsg{MethodName: "/testdata.Msg/Test", Request: testdata.NewTestMsg(addr)}
+	msgs := []sdk.Msg{svcMsg, testdata.NewTestMsg(addr)}
+
+	bldr := newBuilder(marshaler, std.DefaultPublicKeyCodec{})
+	require.NoError(t, bldr.SetMsgs(msgs...))
+	require.Equal(t, msgs, bldr.GetMsgs())
+
+	// the request is packed with the method name as type URL
+	require.Equal(t, "/testdata.Msg/Test", bldr.tx.Body.Messages[0].TypeUrl)
+
+	txBytes, err := DefaultTxEncoder(marshaler)(bldr)
+	require.NoError(t, err)
+
+	decoded, err := DefaultTxDecoder(marshaler, std.DefaultPublicKeyCodec{})(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, msgs, decoded.GetMsgs())
+}
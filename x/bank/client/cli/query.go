@@ -16,7 +16,10 @@ import (
 )
 
 const (
-	FlagDenom = "denom"
+	FlagDenom        = "denom"
+	FlagDisplayUnits = "display-units"
+	FlagAddress      = "address"
+	FlagCount        = "count"
 )
 
 // GetQueryCmd returns the parent command for all x/bank CLi query commands. The
@@ -34,6 +37,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
+		GetCmdDenomsMetadata(),
+		GetCmdQuerySendEnabled(),
+		GetCmdQueryBlockedAddresses(),
+		GetCmdQueryDenomOwners(),
 	)
 
 	return cmd
@@ -81,7 +88,7 @@ Example:
 				if err != nil {
 					return err
 				}
-				return clientCtx.PrintOutput(res.Balances)
+				return printCoins(cmd, queryClient, clientCtx, res.Balances)
 			}
 
 			params := types.NewQueryBalanceRequest(addr, denom)
@@ -90,11 +97,16 @@ Example:
 				return err
 			}
 
+			if displayUnits, _ := cmd.Flags().GetBool(FlagDisplayUnits); displayUnits {
+				return printCoins(cmd, queryClient, clientCtx, sdk.Coins{*res.Balance})
+			}
+
 			return clientCtx.PrintOutput(res.Balance)
 		},
 	}
 
 	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
+	cmd.Flags().Bool(FlagDisplayUnits, false, "Print amounts in the display units of their denominations")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -131,12 +143,25 @@ To query for the total supply of a specific coin denomination use:
 			queryClient := types.NewQueryClient(clientCtx)
 
 			if denom == "" {
-				res, err := queryClient.TotalSupply(context.Background(), &types.QueryTotalSupplyRequest{})
-				if err != nil {
-					return err
-				}
+				var (
+					supply  sdk.Coins
+					pageReq = &query.PageRequest{}
+				)
+
+				for {
+					res, err := queryClient.TotalSupply(context.Background(), &types.QueryTotalSupplyRequest{Req: pageReq})
+					if err != nil {
+						return err
+					}
+
+					supply = append(supply, res.Supply...)
 
-				return clientCtx.PrintOutput(res.Supply)
+					if res.Res == nil || len(res.Res.NextKey) == 0 {
+						return printCoins(cmd, queryClient, clientCtx, supply)
+					}
+
+					pageReq = &query.PageRequest{Key: res.Res.NextKey}
+				}
 			}
 
 			res, err := queryClient.SupplyOf(context.Background(), &types.QuerySupplyOfRequest{Denom: denom})
@@ -144,12 +169,322 @@ To query for the total supply of a specific coin denomination use:
 				return err
 			}
 
+			if displayUnits, _ := cmd.Flags().GetBool(FlagDisplayUnits); displayUnits {
+				return printCoins(cmd, queryClient, clientCtx, sdk.Coins{res.Amount})
+			}
+
 			return clientCtx.PrintOutput(res.Amount)
 		},
 	}
 
 	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
+	cmd.Flags().Bool(FlagDisplayUnits, false, "Print amounts in the display units of their denominations")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdDenomsMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-metadata",
+		Short: "Query the metadata of coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the metadata of all the coin denominations that have metadata.
+
+Example:
+  $ %s query %s denom-metadata
+
+To query for the metadata of a specific coin denomination use:
+  $ %s query %s denom-metadata --denom=[denom]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if denom == "" {
+				metadatas, err := queryDenomsMetadata(queryClient)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintOutput(metadatas)
+			}
+
+			res, err := queryClient.DenomMetadata(context.Background(), &types.QueryDenomMetadataRequest{Denom: denom})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.Metadata)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The specific denomination to query the metadata of")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
+
+func GetCmdQuerySendEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-enabled",
+		Short: "Query the send enabled statuses of coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the send enabled statuses set for coin denominations by governance or at
+genesis, which take precedence over the send enabled parameters.
+
+Example:
+  $ %s query %s send-enabled
+
+To query whether sending a specific coin denomination is enabled use:
+  $ %s query %s send-enabled --denom=[denom]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if denom != "" {
+				res, err := queryClient.SendEnabledOf(context.Background(), &types.QuerySendEnabledOfRequest{Denom: denom})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintOutput(res)
+			}
+
+			var (
+				sendEnabled []types.SendEnabled
+				pageReq     = &query.PageRequest{}
+			)
+
+			for {
+				res, err := queryClient.SendEnabled(context.Background(), &types.QuerySendEnabledRequest{Req: pageReq})
+				if err != nil {
+					return err
+				}
+
+				sendEnabled = append(sendEnabled, res.SendEnabled...)
+
+				if res.Res == nil || len(res.Res.NextKey) == 0 {
+					return clientCtx.PrintOutput(sendEnabled)
+				}
+
+				pageReq = &query.PageRequest{Key: res.Res.NextKey}
+			}
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The specific denomination to query whether sending is enabled")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryBlockedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-addresses",
+		Short: "Query the addresses restricted from receiving funds",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the addresses blocked by governance or at genesis from receiving funds.
+The addresses blocked by the application, e.g. module accounts, are not listed.
+
+Example:
+  $ %s query %s blocked-addresses
+
+To query whether a specific address is restricted from receiving funds, either by
+governance or by the application, use:
+  $ %s query %s blocked-addresses --address=[address]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			addrStr, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if addrStr != "" {
+				addr, err := sdk.AccAddressFromBech32(addrStr)
+				if err != nil {
+					return err
+				}
+
+				res, err := queryClient.BlockedAddress(context.Background(), &types.QueryBlockedAddressRequest{Address: addr})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintOutput(res)
+			}
+
+			var (
+				addrs   []sdk.AccAddress
+				pageReq = &query.PageRequest{}
+			)
+
+			for {
+				res, err := queryClient.BlockedAddresses(context.Background(), &types.QueryBlockedAddressesRequest{Req: pageReq})
+				if err != nil {
+					return err
+				}
+
+				addrs = append(addrs, res.Addresses...)
+
+				if res.Res == nil || len(res.Res.NextKey) == 0 {
+					return clientCtx.PrintOutput(addrs)
+				}
+
+				pageReq = &query.PageRequest{Key: res.Res.NextKey}
+			}
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "The specific address to query whether it is restricted from receiving funds")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryDenomOwners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-owners [denom]",
+		Short: "Query the accounts holding a denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the accounts holding a denomination, with their balances.
+
+Example:
+  $ %s query %s denom-owners [denom]
+
+To query only the number of accounts holding the denomination, use:
+  $ %s query %s denom-owners [denom] --count
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			count, err := cmd.Flags().GetBool(FlagCount)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if count {
+				res, err := queryClient.DenomOwnersCount(context.Background(), &types.QueryDenomOwnersCountRequest{Denom: args[0]})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintOutput(res)
+			}
+
+			var (
+				owners  []types.DenomOwner
+				pageReq = &query.PageRequest{}
+			)
+
+			for {
+				res, err := queryClient.DenomOwners(
+					context.Background(), &types.QueryDenomOwnersRequest{Denom: args[0], Req: pageReq},
+				)
+				if err != nil {
+					return err
+				}
+
+				owners = append(owners, res.DenomOwners...)
+
+				if res.Res == nil || len(res.Res.NextKey) == 0 {
+					return clientCtx.PrintOutput(owners)
+				}
+
+				pageReq = &query.PageRequest{Key: res.Res.NextKey}
+			}
+		},
+	}
+
+	cmd.Flags().Bool(FlagCount, false, "Query only the number of accounts holding the denomination")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// queryDenomsMetadata queries the metadata of all the denominations that have
+// metadata, page by page.
+func queryDenomsMetadata(queryClient types.QueryClient) ([]types.Metadata, error) {
+	var (
+		metadatas []types.Metadata
+		pageReq   = &query.PageRequest{}
+	)
+
+	for {
+		res, err := queryClient.DenomsMetadata(context.Background(), &types.QueryDenomsMetadataRequest{Req: pageReq})
+		if err != nil {
+			return nil, err
+		}
+
+		metadatas = append(metadatas, res.Metadatas...)
+
+		if res.Res == nil || len(res.Res.NextKey) == 0 {
+			return metadatas, nil
+		}
+
+		pageReq = &query.PageRequest{Key: res.Res.NextKey}
+	}
+}
+
+// printCoins prints the given coins, in the display units of their
+// denominations if the display units flag is set.
+func printCoins(cmd *cobra.Command, queryClient types.QueryClient, clientCtx client.Context, coins sdk.Coins) error {
+	if displayUnits, _ := cmd.Flags().GetBool(FlagDisplayUnits); !displayUnits {
+		return clientCtx.PrintOutput(coins)
+	}
+
+	metadatas, err := queryDenomsMetadata(queryClient)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintOutput(types.FormatCoins(coins, metadatas))
+}
@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewTxCmd returns a root CLI command handler for all x/bank transaction commands.
@@ -45,12 +50,101 @@ func NewSendTxCmd() *cobra.Command {
 				return err
 			}
 
-			coins, err := sdk.ParseCoins(args[2])
+			var coins sdk.Coins
+
+			if displayUnits, _ := cmd.Flags().GetBool(FlagDisplayUnits); displayUnits {
+				metadatas, err := queryDenomsMetadata(types.NewQueryClient(clientCtx))
+				if err != nil {
+					return err
+				}
+
+				coins, err = types.ParseCoins(args[2], metadatas)
+				if err != nil {
+					return err
+				}
+			} else {
+				coins, err = sdk.ParseCoins(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSend(clientCtx.GetFromAddress(), toAddr, coins)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagDisplayUnits, false, "Parse the amount in any unit of the denominations with metadata, e.g. 1.5atom")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitSendEnabledProposal implements the command to submit a send-enabled proposal
+func GetCmdSubmitSendEnabledProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-enabled [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a send enabled proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal setting whether sending denominations is enabled, along with an
+initial deposit. The send enabled status set for a denomination takes precedence
+over the bank parameters, and the denominations listed in use_default_for have
+their status removed for the parameters to apply to them again. The proposal
+details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal send-enabled <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Disable Foo Transfers",
+  "description": "Disable the transfers of foo, and restore the default for bar",
+  "send_enabled": [
+    {
+      "denom": "foo",
+      "enabled": false
+    }
+  ],
+  "use_default_for": ["bar"],
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseSendEnabledProposalJSON(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewSendEnabledProposal(
+				proposal.Title, proposal.Description, proposal.SendEnabled, proposal.UseDefaultFor,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgSend(clientCtx.GetFromAddress(), toAddr, coins)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -60,6 +154,69 @@ func NewSendTxCmd() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSubmitBlockedAddressesProposal implements the command to submit a blocked-addresses proposal
+func GetCmdSubmitBlockedAddressesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-addresses [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a blocked addresses proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal blocking addresses from receiving funds, or unblocking addresses
+previously blocked by governance, along with an initial deposit. The proposal
+details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal blocked-addresses <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Block Address",
+  "description": "Block the funds sent by mistake to an unspendable address",
+  "block": ["cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq"],
+  "unblock": [],
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseBlockedAddressesProposalJSON(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewBlockedAddressesProposal(proposal.Title, proposal.Description, proposal.Block, proposal.Unblock)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
 
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
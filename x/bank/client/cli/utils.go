@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type (
+	// SendEnabledProposalJSON defines a SendEnabledProposal with a deposit
+	SendEnabledProposalJSON struct {
+		Title         string              `json:"title" yaml:"title"`
+		Description   string              `json:"description" yaml:"description"`
+		SendEnabled   []types.SendEnabled `json:"send_enabled" yaml:"send_enabled"`
+		UseDefaultFor []string            `json:"use_default_for" yaml:"use_default_for"`
+		Deposit       string              `json:"deposit" yaml:"deposit"`
+	}
+
+	// BlockedAddressesProposalJSON defines a BlockedAddressesProposal with a deposit
+	BlockedAddressesProposalJSON struct {
+		Title       string           `json:"title" yaml:"title"`
+		Description string           `json:"description" yaml:"description"`
+		Block       []sdk.AccAddress `json:"block" yaml:"block"`
+		Unblock     []sdk.AccAddress `json:"unblock" yaml:"unblock"`
+		Deposit     string           `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseSendEnabledProposalJSON reads and parses a SendEnabledProposalJSON from a file.
+func ParseSendEnabledProposalJSON(cdc codec.JSONMarshaler, proposalFile string) (SendEnabledProposalJSON, error) {
+	proposal := SendEnabledProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseBlockedAddressesProposalJSON reads and parses a BlockedAddressesProposalJSON from a file.
+func ParseBlockedAddressesProposalJSON(cdc codec.JSONMarshaler, proposalFile string) (BlockedAddressesProposalJSON, error) {
+	proposal := BlockedAddressesProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
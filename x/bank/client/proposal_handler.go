@@ -0,0 +1,14 @@
+package client
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/bank/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+// SendEnabledProposalHandler and BlockedAddressesProposalHandler are the send
+// enabled and blocked addresses proposal handlers.
+var (
+	SendEnabledProposalHandler      = govclient.NewProposalHandler(cli.GetCmdSubmitSendEnabledProposal, rest.SendEnabledProposalRESTHandler)
+	BlockedAddressesProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitBlockedAddressesProposal, rest.BlockedAddressesProposalRESTHandler)
+)
@@ -0,0 +1,108 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type (
+	// SendEnabledProposalReq defines a send enabled proposal request body.
+	SendEnabledProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title         string              `json:"title" yaml:"title"`
+		Description   string              `json:"description" yaml:"description"`
+		SendEnabled   []types.SendEnabled `json:"send_enabled" yaml:"send_enabled"`
+		UseDefaultFor []string            `json:"use_default_for" yaml:"use_default_for"`
+		Proposer      sdk.AccAddress      `json:"proposer" yaml:"proposer"`
+		Deposit       sdk.Coins           `json:"deposit" yaml:"deposit"`
+	}
+
+	// BlockedAddressesProposalReq defines a blocked addresses proposal request body.
+	BlockedAddressesProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string           `json:"title" yaml:"title"`
+		Description string           `json:"description" yaml:"description"`
+		Block       []sdk.AccAddress `json:"block" yaml:"block"`
+		Unblock     []sdk.AccAddress `json:"unblock" yaml:"unblock"`
+		Proposer    sdk.AccAddress   `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins        `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// TODO add proto compatible Handler after x/gov migration
+// SendEnabledProposalRESTHandler returns a ProposalRESTHandler that exposes the send enabled REST handler with a given sub-route.
+func SendEnabledProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "send_enabled",
+		Handler:  postSendEnabledProposalHandlerFn(clientCtx),
+	}
+}
+
+// BlockedAddressesProposalRESTHandler returns a ProposalRESTHandler that exposes the blocked addresses REST handler with a given sub-route.
+func BlockedAddressesProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "blocked_addresses",
+		Handler:  postBlockedAddressesProposalHandlerFn(clientCtx),
+	}
+}
+
+func postSendEnabledProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SendEnabledProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSendEnabledProposal(req.Title, req.Description, req.SendEnabled, req.UseDefaultFor)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postBlockedAddressesProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req BlockedAddressesProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewBlockedAddressesProposal(req.Title, req.Description, req.Block, req.Unblock)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
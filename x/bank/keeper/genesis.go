@@ -31,6 +31,18 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	}
 
 	k.SetSupply(ctx, types.NewSupply(genState.Supply))
+
+	for _, metadata := range genState.DenomMetadata {
+		k.SetDenomMetaData(ctx, metadata)
+	}
+
+	for _, se := range genState.SendEnabled {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+
+	for _, addr := range genState.BlockedAddresses {
+		k.BlockAddr(ctx, addr)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -56,5 +68,28 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 		})
 	}
 
-	return types.NewGenesisState(k.GetParams(ctx), balances, k.GetSupply(ctx).GetTotal())
+	denomMetadata := []types.Metadata{}
+
+	k.IterateAllDenomMetaData(ctx, func(metadata types.Metadata) bool {
+		denomMetadata = append(denomMetadata, metadata)
+		return false
+	})
+
+	sendEnabled := []types.SendEnabled{}
+
+	k.IterateSendEnabled(ctx, func(denom string, enabled bool) bool {
+		sendEnabled = append(sendEnabled, types.SendEnabled{Denom: denom, Enabled: enabled})
+		return false
+	})
+
+	blockedAddrs := []sdk.AccAddress{}
+
+	k.IterateBlockedAddrs(ctx, func(addr sdk.AccAddress) bool {
+		blockedAddrs = append(blockedAddrs, addr)
+		return false
+	})
+
+	return types.NewGenesisState(
+		k.GetParams(ctx), balances, k.GetSupply(ctx).GetTotal(), denomMetadata, sendEnabled, blockedAddrs,
+	)
 }
@@ -70,11 +70,31 @@ func (q BaseKeeper) AllBalances(c context.Context, req *types.QueryAllBalancesRe
 }
 
 // TotalSupply implements the Query/TotalSupply gRPC method
-func (q BaseKeeper) TotalSupply(c context.Context, _ *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+func (q BaseKeeper) TotalSupply(c context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
-	totalSupply := q.GetSupply(ctx).GetTotal()
 
-	return &types.QueryTotalSupplyResponse{Supply: totalSupply}, nil
+	supply := sdk.NewCoins()
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.SupplyPrefix)
+
+	res, err := query.Paginate(store, req.Req, func(key []byte, value []byte) error {
+		var amount sdk.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return err
+		}
+
+		supply = append(supply, sdk.NewCoin(string(key), amount))
+		return nil
+	})
+
+	if err != nil {
+		return &types.QueryTotalSupplyResponse{}, err
+	}
+
+	return &types.QueryTotalSupplyResponse{Supply: supply, Res: res}, nil
 }
 
 // SupplyOf implements the Query/SupplyOf gRPC method
@@ -88,7 +108,174 @@ func (q BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest)
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	supply := q.GetSupply(ctx).GetTotal().AmountOf(req.Denom)
 
-	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply)}, nil
+	return &types.QuerySupplyOfResponse{Amount: q.GetSupplyOf(ctx, req.Denom)}, nil
+}
+
+// DenomMetadata implements the Query/DenomMetadata gRPC method
+func (q BaseKeeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	metadata, found := q.GetDenomMetaData(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no metadata for denom %s", req.Denom)
+	}
+
+	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
+}
+
+// DenomsMetadata implements the Query/DenomsMetadata gRPC method
+func (q BaseKeeper) DenomsMetadata(c context.Context, req *types.QueryDenomsMetadataRequest) (*types.QueryDenomsMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var metadatas []types.Metadata
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DenomMetadataPrefix)
+
+	res, err := query.Paginate(store, req.Req, func(_ []byte, value []byte) error {
+		var metadata types.Metadata
+		if err := q.cdc.UnmarshalBinaryBare(value, &metadata); err != nil {
+			return err
+		}
+
+		metadatas = append(metadatas, metadata)
+		return nil
+	})
+
+	if err != nil {
+		return &types.QueryDenomsMetadataResponse{}, err
+	}
+
+	return &types.QueryDenomsMetadataResponse{Metadatas: metadatas, Res: res}, nil
+}
+
+// SendEnabled implements the Query/SendEnabled gRPC method
+func (q BaseKeeper) SendEnabled(c context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var sendEnabled []types.SendEnabled
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.SendEnabledPrefix)
+
+	res, err := query.Paginate(store, req.Req, func(key []byte, value []byte) error {
+		sendEnabled = append(sendEnabled, types.SendEnabled{Denom: string(key), Enabled: value[0] == 1})
+		return nil
+	})
+
+	if err != nil {
+		return &types.QuerySendEnabledResponse{}, err
+	}
+
+	return &types.QuerySendEnabledResponse{SendEnabled: sendEnabled, Res: res}, nil
+}
+
+// SendEnabledOf implements the Query/SendEnabledOf gRPC method
+func (q BaseKeeper) SendEnabledOf(c context.Context, req *types.QuerySendEnabledOfRequest) (*types.QuerySendEnabledOfResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	enabled := q.SendEnabledCoin(ctx, sdk.Coin{Denom: req.Denom})
+
+	return &types.QuerySendEnabledOfResponse{Enabled: enabled}, nil
+}
+
+// BlockedAddresses implements the Query/BlockedAddresses gRPC method
+func (q BaseKeeper) BlockedAddresses(c context.Context, req *types.QueryBlockedAddressesRequest) (*types.QueryBlockedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var addrs []sdk.AccAddress
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.BlockedAddrPrefix)
+
+	res, err := query.Paginate(store, req.Req, func(key []byte, _ []byte) error {
+		addrs = append(addrs, sdk.AccAddress(append([]byte{}, key...)))
+		return nil
+	})
+
+	if err != nil {
+		return &types.QueryBlockedAddressesResponse{}, err
+	}
+
+	return &types.QueryBlockedAddressesResponse{Addresses: addrs, Res: res}, nil
+}
+
+// BlockedAddress implements the Query/BlockedAddress gRPC method
+func (q BaseKeeper) BlockedAddress(c context.Context, req *types.QueryBlockedAddressRequest) (*types.QueryBlockedAddressResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Address) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBlockedAddressResponse{Blocked: q.BlockedAddr(ctx, req.Address)}, nil
+}
+
+// DenomOwners implements the Query/DenomOwners gRPC method
+func (q BaseKeeper) DenomOwners(c context.Context, req *types.QueryDenomOwnersRequest) (*types.QueryDenomOwnersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var owners []types.DenomOwner
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DenomOwnersStorePrefix(req.Denom))
+
+	res, err := query.Paginate(store, req.Req, func(key []byte, _ []byte) error {
+		addr := sdk.AccAddress(append([]byte{}, key...))
+		owners = append(owners, types.DenomOwner{Address: addr, Balance: q.GetBalance(ctx, addr, req.Denom)})
+		return nil
+	})
+
+	if err != nil {
+		return &types.QueryDenomOwnersResponse{}, err
+	}
+
+	return &types.QueryDenomOwnersResponse{DenomOwners: owners, Res: res}, nil
+}
+
+// DenomOwnersCount implements the Query/DenomOwnersCount gRPC method
+func (q BaseKeeper) DenomOwnersCount(c context.Context, req *types.QueryDenomOwnersCountRequest) (*types.QueryDenomOwnersCountResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDenomOwnersCountResponse{Count: q.GetDenomOwnersCount(ctx, req.Denom)}, nil
 }
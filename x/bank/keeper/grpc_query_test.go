@@ -106,6 +106,21 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	suite.Require().NotNil(res)
 
 	suite.Require().Equal(expectedTotalSupply.Total, res.Supply)
+
+	voucher := sdk.NewInt64Coin("transfer/channel0/atom", 100)
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(expectedTotalSupply.Total.Add(voucher)))
+
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{Req: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTotalSupply.Total, res.Supply)
+	suite.Require().Equal(uint64(2), res.Res.Total)
+
+	pageReq = &query.PageRequest{Key: res.Res.NextKey, Limit: 1}
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{Req: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(voucher), res.Supply)
+	suite.Require().Nil(res.Res.NextKey)
 }
 
 func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
@@ -129,3 +144,145 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 
 	suite.Require().Equal(test1Supply, res.Amount)
 }
+
+func (suite *IntegrationTestSuite) TestQueryDenomsMetadata() {
+	app, ctx := suite.app, suite.ctx
+
+	atom := types.NewMetadata(
+		"The native staking token of the Cosmos Hub.", "uatom", "atom",
+		types.NewDenomUnit("uatom", 0, "microatom"),
+		types.NewDenomUnit("atom", 6),
+	)
+	stake := types.NewMetadata("Staking token.", "stake", "stake", types.NewDenomUnit("stake", 0))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: "uatom"})
+	suite.Require().Error(err)
+
+	app.BankKeeper.SetDenomMetaData(ctx, atom)
+	app.BankKeeper.SetDenomMetaData(ctx, stake)
+
+	res, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: "uatom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(atom, res.Metadata)
+
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	allRes, err := queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{Req: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{stake}, allRes.Metadatas)
+	suite.Require().Equal(uint64(2), allRes.Res.Total)
+
+	pageReq = &query.PageRequest{Key: allRes.Res.NextKey}
+	allRes, err = queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{Req: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{atom}, allRes.Metadatas)
+	suite.Require().Empty(allRes.Res.NextKey)
+}
+
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.SendEnabledOf(gocontext.Background(), &types.QuerySendEnabledOfRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.SendEnabledOf(gocontext.Background(), &types.QuerySendEnabledOfRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Enabled)
+
+	app.BankKeeper.SetSendEnabled(ctx, fooDenom, false)
+	app.BankKeeper.SetSendEnabled(ctx, barDenom, true)
+
+	res, err = queryClient.SendEnabledOf(gocontext.Background(), &types.QuerySendEnabledOfRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Enabled)
+
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	allRes, err := queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{Req: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendEnabled{{Denom: barDenom, Enabled: true}}, allRes.SendEnabled)
+	suite.Require().Equal(uint64(2), allRes.Res.Total)
+
+	pageReq = &query.PageRequest{Key: allRes.Res.NextKey}
+	allRes, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{Req: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendEnabled{{Denom: fooDenom, Enabled: false}}, allRes.SendEnabled)
+	suite.Require().Empty(allRes.Res.NextKey)
+}
+
+func (suite *IntegrationTestSuite) TestQueryBlockedAddresses() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := authtypes.KeyTestPubAddr()
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.BlockedAddress(gocontext.Background(), &types.QueryBlockedAddressRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.BlockedAddress(gocontext.Background(), &types.QueryBlockedAddressRequest{Address: addr})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Blocked)
+
+	res, err = queryClient.BlockedAddress(gocontext.Background(), &types.QueryBlockedAddressRequest{Address: feeCollector})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Blocked)
+
+	app.BankKeeper.BlockAddr(ctx, addr)
+
+	res, err = queryClient.BlockedAddress(gocontext.Background(), &types.QueryBlockedAddressRequest{Address: addr})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Blocked)
+
+	// only the addresses blocked by governance are listed
+	allRes, err := queryClient.BlockedAddresses(gocontext.Background(), &types.QueryBlockedAddressesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.AccAddress{addr}, allRes.Addresses)
+}
+
+func (suite *IntegrationTestSuite) TestQueryDenomOwners() {
+	app, ctx := suite.app, suite.ctx
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DenomOwnersCount(gocontext.Background(), &types.QueryDenomOwnersCountRequest{})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(50), newBarCoin(30))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(newFooCoin(20))))
+
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	res, err := queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{Denom: fooDenom, Req: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomOwner{{Address: addr1, Balance: newFooCoin(50)}}, res.DenomOwners)
+	suite.Require().Equal(uint64(2), res.Res.Total)
+	suite.Require().NotNil(res.Res.NextKey)
+
+	pageReq = &query.PageRequest{Key: res.Res.NextKey, Limit: 1}
+	res, err = queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{Denom: fooDenom, Req: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomOwner{{Address: addr2, Balance: newFooCoin(20)}}, res.DenomOwners)
+	suite.Require().Nil(res.Res.NextKey)
+
+	countRes, err := queryClient.DenomOwnersCount(gocontext.Background(), &types.QueryDenomOwnersCountRequest{Denom: barDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), countRes.Count)
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Implements BankHooks interface
+var _ types.BankHooks = BaseSendKeeper{}
+
+// BeforeBalanceChange - call hook if registered
+func (k BaseSendKeeper) BeforeBalanceChange(ctx sdk.Context, addr sdk.AccAddress, oldBalance, newBalance sdk.Coin) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeBalanceChange(ctx, addr, oldBalance, newBalance)
+	}
+
+	return nil
+}
+
+// AfterBalanceChange - call hook if registered
+func (k BaseSendKeeper) AfterBalanceChange(ctx sdk.Context, addr sdk.AccAddress, oldBalance, newBalance sdk.Coin) {
+	if k.hooks != nil {
+		k.hooks.AfterBalanceChange(ctx, addr, oldBalance, newBalance)
+	}
+}
+
+// atomicBalanceChanges runs changes of several balances. When hooks are
+// registered, it runs them in a cache context written only if all of them
+// succeed, so that a failing before hook does not leave the balances changed
+// before it, e.g. a sender debited without the recipient being credited.
+func (k BaseSendKeeper) atomicBalanceChanges(ctx sdk.Context, changes func(ctx sdk.Context) error) error {
+	if k.hooks == nil {
+		return changes(ctx)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := changes(cacheCtx); err != nil {
+		return err
+	}
+
+	writeCache()
+
+	return nil
+}
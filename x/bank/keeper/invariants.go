@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -11,6 +12,7 @@ import (
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding", NonnegativeBalanceInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupply(k))
+	ir.RegisterRoute(types.ModuleName, "denom-owners", DenomOwnersInvariant(k))
 }
 
 // AllInvariants runs all invariants of the X/bank module.
@@ -66,3 +68,77 @@ func TotalSupply(k Keeper) sdk.Invariant {
 				expectedTotal, supply.GetTotal())), broken
 	}
 }
+
+// DenomOwnersInvariant checks that the denomination owners index and counts
+// reflect the non-zero balances held in accounts
+func DenomOwnersInvariant(k ViewKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		owners := make(map[string]map[string]bool)
+
+		k.IterateAllBalances(ctx, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+			if balance.IsZero() {
+				return false
+			}
+
+			if owners[balance.Denom] == nil {
+				owners[balance.Denom] = make(map[string]bool)
+			}
+
+			owners[balance.Denom][addr.String()] = true
+
+			return false
+		})
+
+		// every index entry must have a balance, including the entries of the
+		// denominations no longer held by any account
+		indexed := make(map[string]int)
+
+		k.IterateAllDenomOwners(ctx, func(denom string, addr sdk.AccAddress) bool {
+			indexed[denom]++
+
+			if !owners[denom][addr.String()] {
+				count++
+				msg += fmt.Sprintf("\t%s is indexed as an owner of %s without a balance\n", addr, denom)
+			}
+
+			return false
+		})
+
+		denoms := make([]string, 0, len(owners)+len(indexed))
+		for denom := range owners {
+			denoms = append(denoms, denom)
+		}
+
+		for denom := range indexed {
+			if owners[denom] == nil {
+				denoms = append(denoms, denom)
+			}
+		}
+
+		sort.Strings(denoms)
+
+		for _, denom := range denoms {
+			ownersCount := k.GetDenomOwnersCount(ctx, denom)
+
+			if indexed[denom] != len(owners[denom]) || ownersCount != uint64(len(owners[denom])) {
+				count++
+				msg += fmt.Sprintf(
+					"\t%s has %d owners, %d indexed and a count of %d\n",
+					denom, len(owners[denom]), indexed[denom], ownersCount,
+				)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "denom-owners",
+			fmt.Sprintf("amount of inconsistent denomination owners found %d\n%s", count, msg),
+		), broken
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -29,6 +31,14 @@ type Keeper interface {
 
 	GetSupply(ctx sdk.Context) exported.SupplyI
 	SetSupply(ctx sdk.Context, supply exported.SupplyI)
+	GetSupplyOf(ctx sdk.Context, denom string) sdk.Coin
+	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
+	MigrateLegacySupply(ctx sdk.Context) error
+	MigrateDenomOwners(ctx sdk.Context)
+
+	GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool)
+	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
+	IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) bool)
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
@@ -45,6 +55,8 @@ type Keeper interface {
 	MarshalSupplyJSON(supply exported.SupplyI) ([]byte, error)
 	UnmarshalSupplyJSON(bz []byte) (exported.SupplyI, error)
 
+	Logger(ctx sdk.Context) log.Logger
+
 	types.QueryServer
 }
 
@@ -77,6 +89,22 @@ func NewBaseKeeper(
 	}
 }
 
+// SetHooks sets the hooks called on the balance changes. As the other keepers
+// hold copies of the bank keeper, it must be called before the keeper is
+// passed to them. The operations changing several balances are run in a cache
+// context once hooks are set, so that none of their changes is written if a
+// before hook fails, the after hooks of the changes made before it having been
+// called with the discarded cache context.
+func (k *BaseKeeper) SetHooks(bh types.BankHooks) *BaseKeeper {
+	if k.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	k.hooks = bh
+
+	return k
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
@@ -92,33 +120,32 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	balances := sdk.NewCoins()
+	return k.atomicBalanceChanges(ctx, func(ctx sdk.Context) error {
+		balances := sdk.NewCoins()
 
-	for _, coin := range amt {
-		balance := k.GetBalance(ctx, delegatorAddr, coin.Denom)
-		if balance.IsLT(coin) {
-			return sdkerrors.Wrapf(
-				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s is smaller than %s", balance, amt,
-			)
+		for _, coin := range amt {
+			balance := k.GetBalance(ctx, delegatorAddr, coin.Denom)
+			if balance.IsLT(coin) {
+				return sdkerrors.Wrapf(
+					sdkerrors.ErrInsufficientFunds, "failed to delegate; %s is smaller than %s", balance, amt,
+				)
+			}
+
+			balances = balances.Add(balance)
+			err := k.SetBalance(ctx, delegatorAddr, balance.Sub(coin))
+			if err != nil {
+				return err
+			}
 		}
 
-		balances = balances.Add(balance)
-		err := k.SetBalance(ctx, delegatorAddr, balance.Sub(coin))
-		if err != nil {
-			return err
+		if err := k.trackDelegation(ctx, delegatorAddr, ctx.BlockHeader().Time, balances, amt); err != nil {
+			return sdkerrors.Wrap(err, "failed to track delegation")
 		}
-	}
 
-	if err := k.trackDelegation(ctx, delegatorAddr, ctx.BlockHeader().Time, balances, amt); err != nil {
-		return sdkerrors.Wrap(err, "failed to track delegation")
-	}
+		_, err := k.addCoins(ctx, moduleAccAddr, amt)
 
-	_, err := k.AddCoins(ctx, moduleAccAddr, amt)
-	if err != nil {
 		return err
-	}
-
-	return nil
+	})
 }
 
 // UndelegateCoins performs undelegation by crediting amt coins to an account with
@@ -136,52 +163,151 @@ func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAdd
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	_, err := k.SubtractCoins(ctx, moduleAccAddr, amt)
-	if err != nil {
+	return k.atomicBalanceChanges(ctx, func(ctx sdk.Context) error {
+		_, err := k.subtractCoins(ctx, moduleAccAddr, amt)
+		if err != nil {
+			return err
+		}
+
+		if err := k.trackUndelegation(ctx, delegatorAddr, amt); err != nil {
+			return sdkerrors.Wrap(err, "failed to track undelegation")
+		}
+
+		_, err = k.addCoins(ctx, delegatorAddr, amt)
+
 		return err
+	})
+}
+
+// GetSupply retrieves the supply of all the denominations from store. It
+// iterates over the supply of each denomination, GetSupplyOf should be used to
+// retrieve the supply of a single one.
+func (k BaseKeeper) GetSupply(ctx sdk.Context) exported.SupplyI {
+	total := sdk.NewCoins()
+	k.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+		total = append(total, supply)
+		return false
+	})
+
+	return types.NewSupply(total)
+}
+
+// SetSupply sets the supply of all the denominations to store, removing the
+// supply of the denominations it does not contain.
+func (k BaseKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
+	var denoms []string
+	k.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+		denoms = append(denoms, supply.Denom)
+		return false
+	})
+
+	for _, denom := range denoms {
+		k.setSupplyOf(ctx, sdk.NewCoin(denom, sdk.ZeroInt()))
 	}
 
-	if err := k.trackUndelegation(ctx, delegatorAddr, amt); err != nil {
-		return sdkerrors.Wrap(err, "failed to track undelegation")
+	for _, coin := range supply.GetTotal() {
+		k.setSupplyOf(ctx, coin)
 	}
+}
 
-	_, err = k.AddCoins(ctx, delegatorAddr, amt)
-	if err != nil {
-		return err
+// GetSupplyOf retrieves the supply of a denomination from store.
+func (k BaseKeeper) GetSupplyOf(ctx sdk.Context, denom string) sdk.Coin {
+	bz := ctx.KVStore(k.storeKey).Get(types.SupplyOfKey(denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
 	}
 
-	return nil
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return sdk.NewCoin(denom, amount)
 }
 
-// GetSupply retrieves the Supply from store
-func (k BaseKeeper) GetSupply(ctx sdk.Context) exported.SupplyI {
+// IterateTotalSupply iterates over the supply of the denominations, by
+// denomination, and provides it to a callback. If true is returned from the
+// callback, iteration is halted.
+func (k BaseKeeper) IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool) {
+	supplyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyPrefix)
+
+	iterator := supplyStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		if cb(sdk.NewCoin(string(iterator.Key()), amount)) {
+			break
+		}
+	}
+}
+
+// setSupplyOf sets the supply of a denomination to store, removing it if it
+// is zero.
+func (k BaseKeeper) setSupplyOf(ctx sdk.Context, supply sdk.Coin) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.SupplyKey)
-	if bz == nil {
-		panic("stored supply should not have been nil")
+
+	if supply.IsZero() {
+		store.Delete(types.SupplyOfKey(supply.Denom))
+		return
 	}
 
-	supply, err := k.UnmarshalSupply(bz)
+	bz, err := supply.Amount.Marshal()
 	if err != nil {
 		panic(err)
 	}
 
-	return supply
+	store.Set(types.SupplyOfKey(supply.Denom), bz)
 }
 
-// SetSupply sets the Supply to store
-func (k BaseKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
+// GetDenomMetaData retrieves the metadata of the given base denomination, and
+// whether it has any.
+func (k BaseKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool) {
 	store := ctx.KVStore(k.storeKey)
-	bz, err := k.MarshalSupply(supply)
-	if err != nil {
-		panic(err)
+	bz := store.Get(types.DenomMetadataKey(denom))
+	if bz == nil {
+		return types.Metadata{}, false
 	}
 
-	store.Set(types.SupplyKey, bz)
+	var metadata types.Metadata
+	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+
+	return metadata, true
+}
+
+// SetDenomMetaData sets the metadata of its base denomination.
+func (k BaseKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DenomMetadataKey(denomMetaData.Base), k.cdc.MustMarshalBinaryBare(&denomMetaData))
+}
+
+// IterateAllDenomMetaData iterates over the metadata of all the denominations
+// that have metadata, by base denomination, and calls the given callback on
+// each of them until it returns true.
+func (k BaseKeeper) IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomMetadataPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.Metadata
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &metadata)
+
+		if cb(metadata) {
+			break
+		}
+	}
 }
 
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
-// It will panic if the module account does not exist.
+// It will panic if the module account does not exist. The send enabled statuses
+// do not apply, but an error is returned if the recipient is blocked by the
+// application or by governance.
 func (k BaseKeeper) SendCoinsFromModuleToAccount(
 	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
 ) error {
@@ -191,7 +317,11 @@ func (k BaseKeeper) SendCoinsFromModuleToAccount(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
+	if k.BlockedAddr(ctx, recipientAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", recipientAddr)
+	}
+
+	return k.sendCoins(ctx, senderAddr, recipientAddr, amt)
 }
 
 // SendCoinsFromModuleToModule transfers coins from a ModuleAccount to another.
@@ -210,7 +340,7 @@ func (k BaseKeeper) SendCoinsFromModuleToModule(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	return k.sendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
 // SendCoinsFromAccountToModule transfers coins from an AccAddress to a ModuleAccount.
@@ -224,7 +354,7 @@ func (k BaseKeeper) SendCoinsFromAccountToModule(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	return k.sendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
 // DelegateCoinsFromAccountToModule delegates coins and transfers them from a
@@ -282,11 +412,10 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 		return err
 	}
 
-	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Inflate(amt)
-
-	k.SetSupply(ctx, supply)
+	// update the supply of the minted denominations
+	for _, coin := range amt {
+		k.setSupplyOf(ctx, k.GetSupplyOf(ctx, coin.Denom).Add(coin))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("minted %s from %s module account", amt.String(), moduleName))
@@ -311,10 +440,10 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 		return err
 	}
 
-	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Deflate(amt)
-	k.SetSupply(ctx, supply)
+	// update the supply of the burned denominations
+	for _, coin := range amt {
+		k.setSupplyOf(ctx, k.GetSupplyOf(ctx, coin.Denom).Sub(coin))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("burned %s from %s module account", amt.String(), moduleName))
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -88,6 +89,99 @@ func (suite *IntegrationTestSuite) TestSupply() {
 	suite.Require().Equal(totalSupply, total)
 }
 
+func (suite *IntegrationTestSuite) TestSupplyOf() {
+	app, ctx := suite.app, suite.ctx
+	voucher := sdk.NewInt64Coin("transfer/channel0/atom", 500)
+
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins(newFooCoin(100), newBarCoin(50), voucher)))
+	suite.Require().Equal(newFooCoin(100), app.BankKeeper.GetSupplyOf(ctx, fooDenom))
+	suite.Require().Equal(voucher, app.BankKeeper.GetSupplyOf(ctx, voucher.Denom))
+	suite.Require().Equal(sdk.NewInt64Coin("baz", 0), app.BankKeeper.GetSupplyOf(ctx, "baz"))
+
+	var supply sdk.Coins
+	app.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		supply = append(supply, coin)
+		return len(supply) == 2
+	})
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(50), newFooCoin(100)), supply)
+
+	// setting the supply removes the supply of the denominations it does not contain
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins(newFooCoin(200))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(200)), app.BankKeeper.GetSupply(ctx).GetTotal())
+	suite.Require().True(app.BankKeeper.GetSupplyOf(ctx, voucher.Denom).IsZero())
+}
+
+func (suite *IntegrationTestSuite) TestMigrateLegacySupply() {
+	app, ctx := suite.app, suite.ctx
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	// nothing to migrate
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins(newBarCoin(10))))
+	suite.Require().NoError(app.BankKeeper.MigrateLegacySupply(ctx))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(10)), app.BankKeeper.GetSupply(ctx).GetTotal())
+
+	legacySupply := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	bz, err := app.BankKeeper.MarshalSupply(types.NewSupply(legacySupply))
+	suite.Require().NoError(err)
+	store.Set(types.SupplyKey, bz)
+
+	suite.Require().NoError(app.BankKeeper.MigrateLegacySupply(ctx))
+	suite.Require().Equal(legacySupply, app.BankKeeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(newFooCoin(100), app.BankKeeper.GetSupplyOf(ctx, fooDenom))
+	suite.Require().False(store.Has(types.SupplyKey))
+
+	// an invalid legacy supply is not migrated
+	store.Set(types.SupplyKey, []byte{0x01})
+	suite.Require().Error(app.BankKeeper.MigrateLegacySupply(ctx))
+	suite.Require().True(store.Has(types.SupplyKey))
+}
+
+func (suite *IntegrationTestSuite) TestMigrateDenomOwners() {
+	app, ctx := suite.app, suite.ctx
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(newFooCoin(10))))
+
+	// balances set by prior versions are not indexed, and stale entries are
+	// dropped
+	for _, storePrefix := range [][]byte{types.DenomOwnersPrefix, types.DenomOwnersCountPrefix} {
+		var keys [][]byte
+
+		iterator := sdk.KVStorePrefixIterator(store, storePrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	store.Set(types.DenomOwnerKey(barDenom, addr2), []byte{})
+
+	_, broken := keeper.DenomOwnersInvariant(app.BankKeeper)(ctx)
+	suite.Require().True(broken)
+
+	app.BankKeeper.MigrateDenomOwners(ctx)
+
+	msg, broken := keeper.DenomOwnersInvariant(app.BankKeeper)(ctx)
+	suite.Require().False(broken, msg)
+	suite.Require().Equal(uint64(2), app.BankKeeper.GetDenomOwnersCount(ctx, fooDenom))
+	suite.Require().Equal(uint64(1), app.BankKeeper.GetDenomOwnersCount(ctx, barDenom))
+	suite.Require().False(store.Has(types.DenomOwnerKey(barDenom, addr2)))
+
+	// migrating again changes nothing
+	app.BankKeeper.MigrateDenomOwners(ctx)
+
+	_, broken = keeper.DenomOwnersInvariant(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+	suite.Require().Equal(uint64(2), app.BankKeeper.GetDenomOwnersCount(ctx, fooDenom))
+}
+
 func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
@@ -965,6 +1059,376 @@ func (suite *IntegrationTestSuite) TestUndelegateCoins_Invalid() {
 	suite.Require().Error(app.BankKeeper.UndelegateCoins(ctx, addrModule, addr1, delCoins))
 }
 
+func (suite *IntegrationTestSuite) TestDenomMetadataGenesis() {
+	app, ctx := suite.app, suite.ctx
+
+	metadata := types.NewMetadata(
+		"The native staking token of the Cosmos Hub.", "uatom", "atom",
+		types.NewDenomUnit("uatom", 0, "microatom"),
+		types.NewDenomUnit("atom", 6),
+	)
+
+	genState := app.BankKeeper.ExportGenesis(ctx)
+	genState.DenomMetadata = []types.Metadata{metadata}
+	suite.Require().NoError(types.ValidateGenesis(genState))
+
+	app.BankKeeper.InitGenesis(ctx, genState)
+
+	stored, found := app.BankKeeper.GetDenomMetaData(ctx, "uatom")
+	suite.Require().True(found)
+	suite.Require().Equal(metadata, stored)
+
+	_, found = app.BankKeeper.GetDenomMetaData(ctx, "atom")
+	suite.Require().False(found)
+
+	suite.Require().Equal([]types.Metadata{metadata}, app.BankKeeper.ExportGenesis(ctx).DenomMetadata)
+
+	genState.DenomMetadata = append(genState.DenomMetadata, metadata)
+	suite.Require().Error(types.ValidateGenesis(genState))
+}
+
+func (suite *IntegrationTestSuite) TestSendEnabledStatus() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	params := types.DefaultParams().SetSendEnabledParam(barDenom, false)
+	app.BankKeeper.SetParams(ctx, params)
+
+	_, found := app.BankKeeper.GetSendEnabled(ctx, fooDenom)
+	suite.Require().False(found)
+
+	// the status set in the store takes precedence over the params
+	app.BankKeeper.SetSendEnabled(ctx, fooDenom, false)
+	app.BankKeeper.SetSendEnabled(ctx, barDenom, true)
+
+	enabled, found := app.BankKeeper.GetSendEnabled(ctx, fooDenom)
+	suite.Require().True(found)
+	suite.Require().False(enabled)
+	suite.Require().False(app.BankKeeper.SendEnabledCoin(ctx, newFooCoin(1)))
+	suite.Require().True(app.BankKeeper.SendEnabledCoin(ctx, newBarCoin(1)))
+
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+
+	inputs := []types.Input{{Address: addr1, Coins: sdk.NewCoins(newFooCoin(10))}}
+	outputs := []types.Output{{Address: addr2, Coins: sdk.NewCoins(newFooCoin(10))}}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(newFooCoin(100), app.BankKeeper.GetBalance(ctx, addr1, fooDenom))
+
+	// the send enabled statuses do not apply to the transfers of modules
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromAccountToModule(ctx, addr1, authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, addr1, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(newFooCoin(100), app.BankKeeper.GetBalance(ctx, addr1, fooDenom))
+
+	var denoms []string
+	app.BankKeeper.IterateSendEnabled(ctx, func(denom string, _ bool) bool {
+		denoms = append(denoms, denom)
+		return false
+	})
+	suite.Require().Equal([]string{barDenom, fooDenom}, denoms)
+
+	// removing the status restores the params
+	app.BankKeeper.DeleteSendEnabled(ctx, fooDenom)
+	app.BankKeeper.DeleteSendEnabled(ctx, barDenom)
+	suite.Require().True(app.BankKeeper.SendEnabledCoin(ctx, newFooCoin(1)))
+	suite.Require().False(app.BankKeeper.SendEnabledCoin(ctx, newBarCoin(1)))
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+}
+
+func (suite *IntegrationTestSuite) TestBlockedAddrs() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, feeCollector, balances))
+
+	suite.Require().False(app.BankKeeper.BlockedAddr(ctx, addr2))
+	app.BankKeeper.BlockAddr(ctx, addr2)
+	suite.Require().True(app.BankKeeper.BlockedAddr(ctx, addr2))
+
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().Error(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, addr2, sendAmt))
+
+	inputs := []types.Input{{Address: addr1, Coins: sendAmt}}
+	outputs := []types.Output{{Address: addr2, Coins: sendAmt}}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).Empty())
+
+	var blocked []sdk.AccAddress
+	app.BankKeeper.IterateBlockedAddrs(ctx, func(addr sdk.AccAddress) bool {
+		blocked = append(blocked, addr)
+		return false
+	})
+	suite.Require().Equal([]sdk.AccAddress{addr2}, blocked)
+
+	suite.Require().NoError(app.BankKeeper.UnblockAddr(ctx, addr2))
+	suite.Require().False(app.BankKeeper.BlockedAddr(ctx, addr2))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, addr2, sendAmt))
+
+	// the addresses blocked by the application cannot be unblocked
+	suite.Require().True(app.BankKeeper.BlockedAddr(ctx, feeCollector))
+	suite.Require().Error(app.BankKeeper.UnblockAddr(ctx, feeCollector))
+	suite.Require().True(app.BankKeeper.BlockedAddr(ctx, feeCollector))
+
+	// but module accounts can still be sent coins by their modules
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromAccountToModule(ctx, addr1, authtypes.FeeCollectorName, sendAmt))
+}
+
+func (suite *IntegrationTestSuite) TestSendEnabledProposal() {
+	app, ctx := suite.app, suite.ctx
+
+	app.BankKeeper.SetSendEnabled(ctx, barDenom, false)
+
+	p := types.NewSendEnabledProposal("title", "description", []types.SendEnabled{{Denom: fooDenom, Enabled: false}}, []string{barDenom})
+	suite.Require().NoError(p.ValidateBasic())
+	suite.Require().NoError(keeper.HandleSendEnabledProposal(ctx, app.BankKeeper, p))
+
+	enabled, found := app.BankKeeper.GetSendEnabled(ctx, fooDenom)
+	suite.Require().True(found)
+	suite.Require().False(enabled)
+
+	_, found = app.BankKeeper.GetSendEnabled(ctx, barDenom)
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestBlockedAddressesProposal() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	app.BankKeeper.BlockAddr(ctx, addr2)
+
+	p := types.NewBlockedAddressesProposal("title", "description", []sdk.AccAddress{addr1}, []sdk.AccAddress{addr2})
+	suite.Require().NoError(p.ValidateBasic())
+	suite.Require().NoError(keeper.HandleBlockedAddressesProposal(ctx, app.BankKeeper, p))
+
+	suite.Require().True(app.BankKeeper.BlockedAddr(ctx, addr1))
+	suite.Require().False(app.BankKeeper.BlockedAddr(ctx, addr2))
+
+	// unblocking an address blocked by the application fails without any change
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	p = types.NewBlockedAddressesProposal("title", "description", []sdk.AccAddress{addr2}, []sdk.AccAddress{addr1, feeCollector})
+	suite.Require().Error(keeper.HandleBlockedAddressesProposal(ctx, app.BankKeeper, p))
+	suite.Require().True(app.BankKeeper.BlockedAddr(ctx, addr1))
+	suite.Require().False(app.BankKeeper.BlockedAddr(ctx, addr2))
+}
+
+func (suite *IntegrationTestSuite) TestSendPoliciesGenesis() {
+	app, ctx := suite.app, suite.ctx
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	sendEnabled := []types.SendEnabled{{Denom: barDenom, Enabled: true}, {Denom: fooDenom, Enabled: false}}
+
+	genState := app.BankKeeper.ExportGenesis(ctx)
+	suite.Require().Empty(genState.SendEnabled)
+	suite.Require().Empty(genState.BlockedAddresses)
+
+	genState.SendEnabled = sendEnabled
+	genState.BlockedAddresses = []sdk.AccAddress{addr}
+	suite.Require().NoError(types.ValidateGenesis(genState))
+
+	app.BankKeeper.InitGenesis(ctx, genState)
+
+	suite.Require().False(app.BankKeeper.SendEnabledCoin(ctx, newFooCoin(1)))
+	suite.Require().True(app.BankKeeper.BlockedAddr(ctx, addr))
+
+	exported := app.BankKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(sendEnabled, exported.SendEnabled)
+	suite.Require().Equal([]sdk.AccAddress{addr}, exported.BlockedAddresses)
+
+	genState.SendEnabled = append(genState.SendEnabled, sendEnabled[0])
+	suite.Require().Error(types.ValidateGenesis(genState))
+
+	genState.SendEnabled = sendEnabled
+	genState.BlockedAddresses = append(genState.BlockedAddresses, addr)
+	suite.Require().Error(types.ValidateGenesis(genState))
+}
+
+// balanceChange is a balance change recorded by the mockBankHooks.
+type balanceChange struct {
+	addr                   sdk.AccAddress
+	oldBalance, newBalance string
+}
+
+// mockBankHooks records the balance changes, and fails the changes of the
+// balance of a denom above a limit.
+type mockBankHooks struct {
+	before, after []balanceChange
+	limit         sdk.Coin
+}
+
+func (h *mockBankHooks) BeforeBalanceChange(_ sdk.Context, addr sdk.AccAddress, oldBalance, newBalance sdk.Coin) error {
+	if newBalance.Denom == h.limit.Denom && newBalance.IsGTE(h.limit) {
+		return fmt.Errorf("balance %s exceeds the limit %s", newBalance, h.limit)
+	}
+
+	h.before = append(h.before, balanceChange{addr, oldBalance.String(), newBalance.String()})
+
+	return nil
+}
+
+func (h *mockBankHooks) AfterBalanceChange(_ sdk.Context, addr sdk.AccAddress, oldBalance, newBalance sdk.Coin) {
+	h.after = append(h.after, balanceChange{addr, oldBalance.String(), newBalance.String()})
+}
+
+func (suite *IntegrationTestSuite) TestBankHooks() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	appCodec := app.AppCodec()
+
+	maccPerms := simapp.GetMaccPerms()
+	maccPerms[authtypes.Burner] = []string{authtypes.Burner}
+	maccPerms[authtypes.Minter] = []string{authtypes.Minter}
+	maccPerms[multiPerm] = []string{authtypes.Burner, authtypes.Minter, authtypes.Staking}
+
+	authKeeper := authkeeper.NewAccountKeeper(
+		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
+		authtypes.ProtoBaseAccount, maccPerms,
+	)
+	keeper := keeper.NewBaseKeeper(
+		appCodec, app.GetKey(types.StoreKey), authKeeper,
+		app.GetSubspace(types.ModuleName), make(map[string]bool),
+	)
+
+	hooks := &mockBankHooks{limit: newFooCoin(200)}
+	keeper.SetHooks(types.NewMultiBankHooks(hooks))
+	suite.Require().Panics(func() { keeper.SetHooks(hooks) })
+
+	keeper.SetSupply(ctx, types.NewSupply(initCoins))
+	authKeeper.SetModuleAccount(ctx, burnerAcc)
+	authKeeper.SetModuleAccount(ctx, minterAcc)
+	authKeeper.SetModuleAccount(ctx, multiPermAcc)
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	authKeeper.SetAccount(ctx, authKeeper.NewAccountWithAddress(ctx, addr1))
+	authKeeper.SetAccount(ctx, authKeeper.NewAccountWithAddress(ctx, addr2))
+
+	suite.Require().NoError(keeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(100))))
+	suite.Require().NoError(keeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(40))))
+
+	expected := []balanceChange{
+		{addr1, "0foo", "100foo"},
+		{addr1, "100foo", "60foo"},
+		{addr2, "0foo", "40foo"},
+	}
+	suite.Require().Equal(expected, hooks.before)
+	suite.Require().Equal(expected, hooks.after)
+
+	// clearing balances goes through the hooks
+	hooks.before, hooks.after = nil, nil
+	suite.Require().NoError(keeper.SetBalances(ctx, addr2, sdk.NewCoins(newBarCoin(10))))
+	expected = []balanceChange{
+		{addr2, "40foo", "0foo"},
+		{addr2, "0bar", "10bar"},
+	}
+	suite.Require().Equal(expected, hooks.after)
+
+	// so do the mints, burns and delegations
+	hooks.before, hooks.after = nil, nil
+	fooCoins := sdk.NewCoins(newFooCoin(50))
+	suite.Require().NoError(keeper.MintCoins(ctx, authtypes.Minter, fooCoins))
+	suite.Require().NoError(keeper.SendCoinsFromModuleToModule(ctx, authtypes.Minter, authtypes.Burner, fooCoins))
+	suite.Require().NoError(keeper.BurnCoins(ctx, authtypes.Burner, fooCoins))
+	suite.Require().NoError(keeper.DelegateCoins(ctx, addr1, multiPermAcc.GetAddress(), sdk.NewCoins(newFooCoin(10))))
+	expected = []balanceChange{
+		{minterAcc.GetAddress(), "0foo", "50foo"},
+		{minterAcc.GetAddress(), "50foo", "0foo"},
+		{burnerAcc.GetAddress(), "0foo", "50foo"},
+		{burnerAcc.GetAddress(), "50foo", "0foo"},
+		{addr1, "60foo", "50foo"},
+		{multiPermAcc.GetAddress(), "0foo", "10foo"},
+	}
+	suite.Require().Equal(expected, hooks.after)
+
+	// a failing before hook aborts the transfer
+	hooks.before, hooks.after = nil, nil
+	suite.Require().NoError(keeper.SetBalance(ctx, addr2, newFooCoin(195)))
+	suite.Require().Error(keeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(newFooCoin(195), keeper.GetBalance(ctx, addr2, fooDenom))
+	suite.Require().Len(hooks.after, 2)
+
+	// without the sender being debited
+	suite.Require().Equal(newFooCoin(50), keeper.GetBalance(ctx, addr1, fooDenom))
+
+	inputs := []types.Input{{Address: addr1, Coins: sdk.NewCoins(newFooCoin(10))}}
+	outputs := []types.Output{{Address: addr2, Coins: sdk.NewCoins(newFooCoin(10))}}
+	suite.Require().Error(keeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(newFooCoin(50), keeper.GetBalance(ctx, addr1, fooDenom))
+
+	// nor are the balances cleared when setting them fails
+	suite.Require().Error(keeper.SetBalances(ctx, addr1, sdk.NewCoins(newBarCoin(5), newFooCoin(300))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50)), keeper.GetAllBalances(ctx, addr1))
+}
+
+func (suite *IntegrationTestSuite) TestDenomOwners() {
+	app, ctx := suite.app, suite.ctx
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	for _, addr := range []sdk.AccAddress{addr1, addr2, addr3} {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+
+	denomOwners := func(denom string) []sdk.AccAddress {
+		var owners []sdk.AccAddress
+		app.BankKeeper.IterateDenomOwners(ctx, denom, func(addr sdk.AccAddress) bool {
+			owners = append(owners, addr)
+			return false
+		})
+
+		return owners
+	}
+
+	suite.Require().Empty(denomOwners(fooDenom))
+	suite.Require().Zero(app.BankKeeper.GetDenomOwnersCount(ctx, fooDenom))
+
+	// the owners of a denomination are not mixed with those of a longer one
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(100), newBarCoin(10))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr3, sdk.NewCoins(sdk.NewInt64Coin(fooDenom+"bar", 5))))
+	suite.Require().Equal([]sdk.AccAddress{addr1}, denomOwners(fooDenom))
+	suite.Require().Equal(uint64(1), app.BankKeeper.GetDenomOwnersCount(ctx, fooDenom))
+	suite.Require().Equal([]sdk.AccAddress{addr3}, denomOwners(fooDenom+"bar"))
+
+	// receiving a denomination adds an owner, once
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(30))))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(30))))
+	suite.Require().Equal([]sdk.AccAddress{addr1, addr2}, denomOwners(fooDenom))
+	suite.Require().Equal(uint64(2), app.BankKeeper.GetDenomOwnersCount(ctx, fooDenom))
+
+	// spending all of a denomination removes the owner
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(40))))
+	suite.Require().Equal([]sdk.AccAddress{addr2}, denomOwners(fooDenom))
+	suite.Require().Equal(uint64(1), app.BankKeeper.GetDenomOwnersCount(ctx, fooDenom))
+
+	// so does clearing the balances
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Empty(denomOwners(fooDenom))
+	suite.Require().Zero(app.BankKeeper.GetDenomOwnersCount(ctx, fooDenom))
+	suite.Require().Equal([]sdk.AccAddress{addr1, addr2}, denomOwners(barDenom))
+	suite.Require().Equal(uint64(2), app.BankKeeper.GetDenomOwnersCount(ctx, barDenom))
+
+	_, broken := keeper.DenomOwnersInvariant(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+
+	// a stale entry of a denomination no longer held by any account breaks the
+	// invariant
+	ctx.KVStore(app.GetKey(types.StoreKey)).Set(types.DenomOwnerKey(fooDenom, addr1), []byte{})
+	_, broken = keeper.DenomOwnersInvariant(app.BankKeeper)(ctx)
+	suite.Require().True(broken)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
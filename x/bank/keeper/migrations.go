@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MigrateLegacySupply migrates the legacy supply of all the denominations,
+// stored as a single Supply under SupplyKey, to the supply of each
+// denomination stored under its own key, and removes the legacy supply. It
+// does nothing if there is no legacy supply, so that it can be run by the
+// upgrade handler of any upgrade from a version storing it.
+func (k BaseKeeper) MigrateLegacySupply(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.SupplyKey)
+	if bz == nil {
+		return nil
+	}
+
+	supply, err := k.UnmarshalSupply(bz)
+	if err != nil {
+		return err
+	}
+
+	if err := supply.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, coin := range supply.GetTotal() {
+		k.setSupplyOf(ctx, coin)
+	}
+
+	store.Delete(types.SupplyKey)
+
+	k.Logger(ctx).Info("migrated the legacy total supply", "denoms", len(supply.GetTotal()))
+
+	return nil
+}
+
+// MigrateDenomOwners builds the index of the owners of each denomination and
+// their number from the balances held in accounts, which prior versions did not
+// index. Any existing index entries and counts are replaced, so that it can be
+// run by the upgrade handler of any upgrade from a version not indexing them.
+func (k BaseKeeper) MigrateDenomOwners(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, storePrefix := range [][]byte{types.DenomOwnersPrefix, types.DenomOwnersCountPrefix} {
+		var keys [][]byte
+
+		iterator := sdk.KVStorePrefixIterator(store, storePrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	// the balances are collected before writing the index, as the store is not
+	// written to while iterating over it
+	var owners [][]byte
+
+	counts := make(map[string]uint64)
+
+	k.IterateAllBalances(ctx, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+		if !balance.IsZero() {
+			owners = append(owners, types.DenomOwnerKey(balance.Denom, addr))
+			counts[balance.Denom]++
+		}
+
+		return false
+	})
+
+	for _, key := range owners {
+		store.Set(key, []byte{})
+	}
+
+	for denom, count := range counts {
+		k.setDenomOwnersCount(ctx, denom, count)
+	}
+
+	k.Logger(ctx).Info("migrated the denomination owners index", "owners", len(owners), "denoms", len(counts))
+}
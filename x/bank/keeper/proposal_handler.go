@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// HandleSendEnabledProposal is a handler for executing a passed send enabled proposal
+func HandleSendEnabledProposal(ctx sdk.Context, k Keeper, p *types.SendEnabledProposal) error {
+	logger := k.Logger(ctx)
+
+	for _, se := range p.SendEnabled {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+		logger.Info(fmt.Sprintf("set send enabled status of denom %s to %t", se.Denom, se.Enabled))
+	}
+
+	for _, denom := range p.UseDefaultFor {
+		k.DeleteSendEnabled(ctx, denom)
+		logger.Info(fmt.Sprintf("removed send enabled status of denom %s", denom))
+	}
+
+	return nil
+}
+
+// HandleBlockedAddressesProposal is a handler for executing a passed blocked addresses proposal
+func HandleBlockedAddressesProposal(ctx sdk.Context, k Keeper, p *types.BlockedAddressesProposal) error {
+	logger := k.Logger(ctx)
+
+	// the addresses are unblocked and blocked in a cache context, written only
+	// once all of them are, so that a proposal failing on an address blocked by
+	// the application does not change any state
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	for _, addr := range p.Unblock {
+		if err := k.UnblockAddr(cacheCtx, addr); err != nil {
+			return err
+		}
+	}
+
+	for _, addr := range p.Block {
+		k.BlockAddr(cacheCtx, addr)
+	}
+
+	writeCache()
+
+	for _, addr := range p.Unblock {
+		logger.Info(fmt.Sprintf("unblocked address %s", addr))
+	}
+
+	for _, addr := range p.Block {
+		logger.Info(fmt.Sprintf("blocked address %s", addr))
+	}
+
+	return nil
+}
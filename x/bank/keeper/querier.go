@@ -99,8 +99,7 @@ func querySupplyOf(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, er
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	amount := k.GetSupply(ctx).GetTotal().AmountOf(params.Denom)
-	supply := sdk.NewCoin(params.Denom, amount)
+	supply := k.GetSupplyOf(ctx, params.Denom)
 
 	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, supply)
 	if err != nil {
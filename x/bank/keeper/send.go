@@ -30,7 +30,15 @@ type SendKeeper interface {
 	SendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
-	BlockedAddr(addr sdk.AccAddress) bool
+	GetSendEnabled(ctx sdk.Context, denom string) (enabled, found bool)
+	SetSendEnabled(ctx sdk.Context, denom string, enabled bool)
+	DeleteSendEnabled(ctx sdk.Context, denom string)
+	IterateSendEnabled(ctx sdk.Context, cb func(denom string, enabled bool) (stop bool))
+
+	BlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool
+	BlockAddr(ctx sdk.Context, addr sdk.AccAddress)
+	UnblockAddr(ctx sdk.Context, addr sdk.AccAddress) error
+	IterateBlockedAddrs(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool))
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -47,6 +55,8 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	hooks types.BankHooks
 }
 
 func NewBaseSendKeeper(
@@ -76,7 +86,9 @@ func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
-// inputs and outputs don't lineup or if any single transfer of tokens fails.
+// inputs and outputs don't lineup, if sending any of the input coins is
+// disabled, if any output address is blocked or if any single transfer of
+// tokens fails.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -85,50 +97,80 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 	}
 
 	for _, in := range inputs {
-		_, err := k.SubtractCoins(ctx, in.Address, in.Coins)
-		if err != nil {
+		if err := k.SendEnabledCoins(ctx, in.Coins...); err != nil {
 			return err
 		}
-
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				sdk.EventTypeMessage,
-				sdk.NewAttribute(types.AttributeKeySender, in.Address.String()),
-			),
-		)
 	}
 
 	for _, out := range outputs {
-		_, err := k.AddCoins(ctx, out.Address, out.Coins)
-		if err != nil {
-			return err
+		if k.BlockedAddr(ctx, out.Address) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", out.Address)
 		}
+	}
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address.String()),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
-			),
-		)
+	return k.atomicBalanceChanges(ctx, func(ctx sdk.Context) error {
+		for _, in := range inputs {
+			_, err := k.subtractCoins(ctx, in.Address, in.Coins)
+			if err != nil {
+				return err
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					sdk.EventTypeMessage,
+					sdk.NewAttribute(types.AttributeKeySender, in.Address.String()),
+				),
+			)
+		}
 
-		// Create account if recipient does not exist.
-		//
-		// NOTE: This should ultimately be removed in favor a more flexible approach
-		// such as delegated fee messages.
-		acc := k.ak.GetAccount(ctx, out.Address)
-		if acc == nil {
-			defer telemetry.IncrCounter(1, "new", "account")
-			k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, out.Address))
+		for _, out := range outputs {
+			_, err := k.addCoins(ctx, out.Address, out.Coins)
+			if err != nil {
+				return err
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeTransfer,
+					sdk.NewAttribute(types.AttributeKeyRecipient, out.Address.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
+				),
+			)
+
+			// Create account if recipient does not exist.
+			//
+			// NOTE: This should ultimately be removed in favor a more flexible approach
+			// such as delegated fee messages.
+			acc := k.ak.GetAccount(ctx, out.Address)
+			if acc == nil {
+				defer telemetry.IncrCounter(1, "new", "account")
+				k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, out.Address))
+			}
 		}
-	}
 
-	return nil
+		return nil
+	})
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// An error is returned if sending any of the coins is disabled, if the
+// receiving account is blocked or upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.SendEnabledCoins(ctx, amt...); err != nil {
+		return err
+	}
+
+	if k.BlockedAddr(ctx, toAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", toAddr)
+	}
+
+	return k.sendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// sendCoins transfers amt coins from a sending account to a receiving account,
+// regardless of the send enabled statuses and blocked addresses. It is used by
+// the transfers to and from module accounts.
+func (k BaseSendKeeper) sendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
@@ -142,32 +184,43 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
-	_, err := k.SubtractCoins(ctx, fromAddr, amt)
-	if err != nil {
-		return err
-	}
+	return k.atomicBalanceChanges(ctx, func(ctx sdk.Context) error {
+		_, err := k.subtractCoins(ctx, fromAddr, amt)
+		if err != nil {
+			return err
+		}
 
-	_, err = k.AddCoins(ctx, toAddr, amt)
-	if err != nil {
-		return err
-	}
+		_, err = k.addCoins(ctx, toAddr, amt)
+		if err != nil {
+			return err
+		}
 
-	// Create account if recipient does not exist.
-	//
-	// NOTE: This should ultimately be removed in favor a more flexible approach
-	// such as delegated fee messages.
-	acc := k.ak.GetAccount(ctx, toAddr)
-	if acc == nil {
-		defer telemetry.IncrCounter(1, "new", "account")
-		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, toAddr))
-	}
+		// Create account if recipient does not exist.
+		//
+		// NOTE: This should ultimately be removed in favor a more flexible approach
+		// such as delegated fee messages.
+		acc := k.ak.GetAccount(ctx, toAddr)
+		if acc == nil {
+			defer telemetry.IncrCounter(1, "new", "account")
+			k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, toAddr))
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // SubtractCoins removes amt coins the account by the given address. An error is
 // returned if the resulting balance is negative or the initial amount is invalid.
-func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
+func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (resultCoins sdk.Coins, err error) {
+	err = k.atomicBalanceChanges(ctx, func(ctx sdk.Context) error {
+		resultCoins, err = k.subtractCoins(ctx, addr, amt)
+		return err
+	})
+
+	return resultCoins, err
+}
+
+func (k BaseSendKeeper) subtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
 	if !amt.IsValid() {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
@@ -200,7 +253,16 @@ func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt
 // AddCoins adds amt to the account balance given by the provided address. An
 // error is returned if the initial amount is invalid or if any resulting new
 // balance is negative.
-func (k BaseSendKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
+func (k BaseSendKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (resultCoins sdk.Coins, err error) {
+	err = k.atomicBalanceChanges(ctx, func(ctx sdk.Context) error {
+		resultCoins, err = k.addCoins(ctx, addr, amt)
+		return err
+	})
+
+	return resultCoins, err
+}
+
+func (k BaseSendKeeper) addCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
 	if !amt.IsValid() {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
@@ -221,11 +283,18 @@ func (k BaseSendKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.C
 	return resultCoins, nil
 }
 
-// ClearBalances removes all balances for a given account by address.
-func (k BaseSendKeeper) ClearBalances(ctx sdk.Context, addr sdk.AccAddress) {
-	keys := [][]byte{}
+// ClearBalances removes all balances for a given account by address. An error
+// is returned if a before hook fails, in which case no balance is removed.
+func (k BaseSendKeeper) ClearBalances(ctx sdk.Context, addr sdk.AccAddress) error {
+	return k.atomicBalanceChanges(ctx, func(ctx sdk.Context) error {
+		return k.clearBalances(ctx, addr)
+	})
+}
+
+func (k BaseSendKeeper) clearBalances(ctx sdk.Context, addr sdk.AccAddress) error {
+	balances := []sdk.Coin{}
 	k.IterateAccountBalances(ctx, addr, func(balance sdk.Coin) bool {
-		keys = append(keys, []byte(balance.Denom))
+		balances = append(balances, balance)
 		return false
 	})
 
@@ -233,33 +302,61 @@ func (k BaseSendKeeper) ClearBalances(ctx sdk.Context, addr sdk.AccAddress) {
 	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
 	accountStore := prefix.NewStore(balancesStore, addr.Bytes())
 
-	for _, key := range keys {
-		accountStore.Delete(key)
+	for _, balance := range balances {
+		zero := sdk.NewCoin(balance.Denom, sdk.ZeroInt())
+		if err := k.BeforeBalanceChange(ctx, addr, balance, zero); err != nil {
+			return err
+		}
+
+		accountStore.Delete([]byte(balance.Denom))
+		k.removeDenomOwner(ctx, balance.Denom, addr)
+		k.AfterBalanceChange(ctx, addr, balance, zero)
 	}
+
+	return nil
 }
 
 // SetBalances sets the balance (multiple coins) for an account by address. It will
 // clear out all balances prior to setting the new coins as to set existing balances
 // to zero if they don't exist in amt. An error is returned upon failure.
 func (k BaseSendKeeper) SetBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error {
-	k.ClearBalances(ctx, addr)
-
-	for _, balance := range balances {
-		err := k.SetBalance(ctx, addr, balance)
-		if err != nil {
+	return k.atomicBalanceChanges(ctx, func(ctx sdk.Context) error {
+		if err := k.clearBalances(ctx, addr); err != nil {
 			return err
 		}
-	}
 
-	return nil
+		for _, balance := range balances {
+			err := k.SetBalance(ctx, addr, balance)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 }
 
-// SetBalance sets the coin balance for an account by address.
+// SetBalance sets the coin balance for an account by address. All the balance
+// changes go through it, so that the bank hooks are called before and after
+// each of them and the denomination owners index is kept up to date. An error
+// is returned if the balance is invalid or the before hook fails, in which case
+// the balance is not set.
 func (k BaseSendKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) error {
 	if !balance.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, balance.String())
 	}
 
+	// the previous balance is only read for the hooks, not to charge the gas of
+	// the read when there are none
+	var oldBalance sdk.Coin
+	if k.hooks != nil {
+		oldBalance = k.GetBalance(ctx, addr, balance.Denom)
+
+		if err := k.BeforeBalanceChange(ctx, addr, oldBalance, balance); err != nil {
+			return err
+		}
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
 	accountStore := prefix.NewStore(balancesStore, addr.Bytes())
@@ -267,9 +364,56 @@ func (k BaseSendKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 	bz := k.cdc.MustMarshalBinaryBare(&balance)
 	accountStore.Set([]byte(balance.Denom), bz)
 
+	if balance.IsZero() {
+		k.removeDenomOwner(ctx, balance.Denom, addr)
+	} else {
+		k.addDenomOwner(ctx, balance.Denom, addr)
+	}
+
+	k.AfterBalanceChange(ctx, addr, oldBalance, balance)
+
 	return nil
 }
 
+// addDenomOwner adds an address to the owners index of a denomination, if it
+// is not in it yet, and increments the number of owners of the denomination.
+func (k BaseSendKeeper) addDenomOwner(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.DenomOwnerKey(denom, addr)
+	if store.Has(key) {
+		return
+	}
+
+	store.Set(key, []byte{})
+	k.setDenomOwnersCount(ctx, denom, k.GetDenomOwnersCount(ctx, denom)+1)
+}
+
+// removeDenomOwner removes an address from the owners index of a denomination,
+// if it is in it, and decrements the number of owners of the denomination.
+func (k BaseSendKeeper) removeDenomOwner(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.DenomOwnerKey(denom, addr)
+	if !store.Has(key) {
+		return
+	}
+
+	store.Delete(key)
+	k.setDenomOwnersCount(ctx, denom, k.GetDenomOwnersCount(ctx, denom)-1)
+}
+
+func (k BaseSendKeeper) setDenomOwnersCount(ctx sdk.Context, denom string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	if count == 0 {
+		store.Delete(types.DenomOwnersCountKey(denom))
+		return
+	}
+
+	store.Set(types.DenomOwnersCountKey(denom), sdk.Uint64ToBigEndian(count))
+}
+
 // SendEnabledCoins checks the coins provide and returns an ErrSendDisabled if
 // any of the coins are not configured for sending.  Returns nil if sending is enabled
 // for all provided coin
@@ -282,13 +426,96 @@ func (k BaseSendKeeper) SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) err
 	return nil
 }
 
-// SendEnabledCoin returns the current SendEnabled status of the provided coin's denom
+// SendEnabledCoin returns the current SendEnabled status of the provided coin's
+// denom. The status set for the denom in the store takes precedence over the
+// params.
 func (k BaseSendKeeper) SendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
+	if enabled, found := k.GetSendEnabled(ctx, coin.Denom); found {
+		return enabled
+	}
+
 	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
 }
 
-// BlockedAddr checks if a given address is restricted from
-// receiving funds.
-func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
-	return k.blockedAddrs[addr.String()]
+// GetSendEnabled returns the send enabled status set for a denom in the store,
+// and whether it is set.
+func (k BaseSendKeeper) GetSendEnabled(ctx sdk.Context, denom string) (enabled, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.SendEnabledKey(denom))
+	if bz == nil {
+		return false, false
+	}
+
+	return bz[0] == 1, true
+}
+
+// SetSendEnabled sets the send enabled status of a denom.
+func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, denom string, enabled bool) {
+	bz := []byte{0}
+	if enabled {
+		bz[0] = 1
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.SendEnabledKey(denom), bz)
+}
+
+// DeleteSendEnabled removes the send enabled status of a denom, for the params
+// to apply to it again.
+func (k BaseSendKeeper) DeleteSendEnabled(ctx sdk.Context, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.SendEnabledKey(denom))
+}
+
+// IterateSendEnabled iterates over the send enabled statuses set in the store,
+// by denom, and calls the given callback on each of them. Iteration stops if
+// the callback returns true.
+func (k BaseSendKeeper) IterateSendEnabled(ctx sdk.Context, cb func(denom string, enabled bool) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()), iterator.Value()[0] == 1) {
+			break
+		}
+	}
+}
+
+// BlockedAddr checks if a given address is restricted from receiving funds,
+// either by the application or by governance.
+func (k BaseSendKeeper) BlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.blockedAddrs[addr.String()] || ctx.KVStore(k.storeKey).Has(types.BlockedAddrKey(addr))
+}
+
+// BlockAddr restricts an address from receiving funds.
+func (k BaseSendKeeper) BlockAddr(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.BlockedAddrKey(addr), []byte{1})
+}
+
+// UnblockAddr removes the restriction set by BlockAddr on an address. An error
+// is returned if the address is blocked by the application, as it can then not
+// be unblocked.
+func (k BaseSendKeeper) UnblockAddr(ctx sdk.Context, addr sdk.AccAddress) error {
+	if k.blockedAddrs[addr.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is blocked by the application and cannot be unblocked", addr)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.BlockedAddrKey(addr))
+
+	return nil
+}
+
+// IterateBlockedAddrs iterates over the addresses blocked by governance and
+// calls the given callback on each of them. Iteration stops if the callback
+// returns true.
+func (k BaseSendKeeper) IterateBlockedAddrs(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockedAddrPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key())) {
+			break
+		}
+	}
 }
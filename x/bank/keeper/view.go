@@ -28,6 +28,10 @@ type ViewKeeper interface {
 
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+
+	GetDenomOwnersCount(ctx sdk.Context, denom string) uint64
+	IterateDenomOwners(ctx sdk.Context, denom string, cb func(address sdk.AccAddress) (stop bool))
+	IterateAllDenomOwners(ctx sdk.Context, cb func(denom string, address sdk.AccAddress) (stop bool))
 }
 
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
@@ -128,6 +132,50 @@ func (k BaseViewKeeper) IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddre
 	}
 }
 
+// GetDenomOwnersCount returns the number of accounts holding a non-zero balance
+// of a denomination.
+func (k BaseViewKeeper) GetDenomOwnersCount(ctx sdk.Context, denom string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.DenomOwnersCountKey(denom))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IterateDenomOwners iterates over the addresses of the accounts holding a
+// non-zero balance of a denomination, in address order, using the denomination
+// owners index. If true is returned from the callback, iteration is halted.
+func (k BaseViewKeeper) IterateDenomOwners(ctx sdk.Context, denom string, cb func(sdk.AccAddress) bool) {
+	ownersStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomOwnersStorePrefix(denom))
+
+	iterator := ownersStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// IterateAllDenomOwners iterates over the denomination owners index, by
+// denomination and address. If true is returned from the callback, iteration is
+// halted.
+func (k BaseViewKeeper) IterateAllDenomOwners(ctx sdk.Context, cb func(string, sdk.AccAddress) bool) {
+	ownersStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomOwnersPrefix)
+
+	iterator := ownersStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom, addr := types.SplitDenomOwnerKey(iterator.Key())
+		if cb(denom, addr) {
+			break
+		}
+	}
+}
+
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
 // account by address. For standard accounts, the result will always be no coins.
 // For vesting accounts, LockedCoins is delegated to the concrete vesting account
@@ -91,6 +91,11 @@ func (am AppModule) RegisterQueryService(server grpc.Server) {
 	types.RegisterQueryServer(server, am.keeper)
 }
 
+// RegisterMsgService registers the bank Msg service.
+func (am AppModule) RegisterMsgService(server grpc.Server) {
+	types.RegisterMsgServer(server, keeper.NewMsgServerImpl(am.keeper))
+}
+
 // NewAppModule creates a new AppModule object
 func NewAppModule(cdc codec.Marshaler, keeper keeper.Keeper, accountKeeper types.AccountKeeper) AppModule {
 	return AppModule{
@@ -8,6 +8,7 @@ import (
 
 	tmkv "github.com/tendermint/tendermint/libs/kv"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -20,7 +21,21 @@ type SupplyUnmarshaller interface {
 func NewDecodeStore(cdc SupplyUnmarshaller) func(kvA, kvB tmkv.Pair) string {
 	return func(kvA, kvB tmkv.Pair) string {
 		switch {
-		case bytes.Equal(kvA.Key[:1], types.SupplyKey):
+		case bytes.Equal(kvA.Key[:1], types.SupplyPrefix):
+			var amountA, amountB sdk.Int
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+
+			denom := string(kvA.Key[1:])
+
+			return fmt.Sprintf("%v\n%v", sdk.NewCoin(denom, amountA), sdk.NewCoin(denom, amountB))
+
+		case bytes.Equal(kvA.Key, types.SupplyKey):
 			supplyA, err := cdc.UnmarshalSupply(kvA.Value)
 			if err != nil {
 				panic(err)
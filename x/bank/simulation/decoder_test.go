@@ -22,7 +22,12 @@ func TestDecodeStore(t *testing.T) {
 	supplyBz, err := app.BankKeeper.MarshalSupply(totalSupply)
 	require.NoError(t, err)
 
+	supplyOf := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	supplyOfBz, err := supplyOf.Amount.Marshal()
+	require.NoError(t, err)
+
 	kvPairs := tmkv.Pairs{
+		tmkv.Pair{Key: types.SupplyOfKey(supplyOf.Denom), Value: supplyOfBz},
 		tmkv.Pair{Key: types.SupplyKey, Value: supplyBz},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
@@ -31,7 +36,8 @@ func TestDecodeStore(t *testing.T) {
 		name        string
 		expectedLog string
 	}{
-		{"Supply", fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"SupplyOf", fmt.Sprintf("%v\n%v", supplyOf, supplyOf)},
+		{"legacy Supply", fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
 		{"other", ""},
 	}
 
@@ -73,8 +73,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 			SendEnabled:        sendEnabledParams,
 			DefaultSendEnabled: defaultSendEnabledParam,
 		},
-		Balances: RandomGenesisBalances(simState),
-		Supply:   supply,
+		Balances:         RandomGenesisBalances(simState),
+		Supply:           supply,
+		DenomMetadata:    []types.Metadata{},
+		SendEnabled:      []types.SendEnabled{},
+		BlockedAddresses: []sdk.AccAddress{},
 	}
 
 	fmt.Printf("Selected randomly generated bank parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, bankGenesis.Params))
@@ -389,6 +389,232 @@ func (m *Supply) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Supply proto.InternalMessageInfo
 
+// DenomUnit represents a unit of a denomination, which is worth 10^exponent
+// units of the base denomination of its Metadata.
+type DenomUnit struct {
+	// denom is the name of the unit
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// exponent is the power of 10 of the base denomination the unit is worth,
+	// 0 for the base denomination itself
+	Exponent uint32 `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// aliases are other names of the unit
+	Aliases []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+}
+
+func (m *DenomUnit) Reset()         { *m = DenomUnit{} }
+func (m *DenomUnit) String() string { return proto.CompactTextString(m) }
+func (*DenomUnit) ProtoMessage()    {}
+func (*DenomUnit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_717c78e54d4b5794, []int{7}
+}
+func (m *DenomUnit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomUnit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomUnit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomUnit.Merge(m, src)
+}
+func (m *DenomUnit) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomUnit) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomUnit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomUnit proto.InternalMessageInfo
+
+func (m *DenomUnit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomUnit) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+func (m *DenomUnit) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+// Metadata describes a denomination, its units and the unit its amounts are
+// displayed in.
+type Metadata struct {
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// denom_units are the units of the denomination, by ascending exponent
+	DenomUnits []DenomUnit `protobuf:"bytes,2,rep,name=denom_units,json=denomUnits,proto3" json:"denom_units" yaml:"denom_units"`
+	// base is the base denomination, the one of the coins of the denomination,
+	// whose unit has exponent 0
+	Base string `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	// display is the unit amounts are displayed in
+	Display string `protobuf:"bytes,4,opt,name=display,proto3" json:"display,omitempty"`
+}
+
+func (m *Metadata) Reset()         { *m = Metadata{} }
+func (m *Metadata) String() string { return proto.CompactTextString(m) }
+func (*Metadata) ProtoMessage()    {}
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_717c78e54d4b5794, []int{8}
+}
+func (m *Metadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Metadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metadata.Merge(m, src)
+}
+func (m *Metadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metadata proto.InternalMessageInfo
+
+func (m *Metadata) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Metadata) GetDenomUnits() []DenomUnit {
+	if m != nil {
+		return m.DenomUnits
+	}
+	return nil
+}
+
+func (m *Metadata) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *Metadata) GetDisplay() string {
+	if m != nil {
+		return m.Display
+	}
+	return ""
+}
+
+// SendEnabledProposal sets the send enabled status of denominations, or removes
+// it for the parameters to apply to them again.
+type SendEnabledProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// send_enabled are the send enabled statuses to set
+	SendEnabled []SendEnabled `protobuf:"bytes,3,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled" yaml:"send_enabled"`
+	// use_default_for are the denominations whose send enabled status to remove
+	UseDefaultFor []string `protobuf:"bytes,4,rep,name=use_default_for,json=useDefaultFor,proto3" json:"use_default_for,omitempty" yaml:"use_default_for"`
+}
+
+func (m *SendEnabledProposal) Reset()      { *m = SendEnabledProposal{} }
+func (*SendEnabledProposal) ProtoMessage() {}
+func (*SendEnabledProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_717c78e54d4b5794, []int{9}
+}
+func (m *SendEnabledProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendEnabledProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendEnabledProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendEnabledProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendEnabledProposal.Merge(m, src)
+}
+func (m *SendEnabledProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendEnabledProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendEnabledProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendEnabledProposal proto.InternalMessageInfo
+
+// BlockedAddressesProposal adds addresses to, and removes addresses from, the
+// list of addresses restricted from receiving funds.
+type BlockedAddressesProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// block are the addresses to add to the list
+	Block []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,rep,name=block,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"block,omitempty"`
+	// unblock are the addresses to remove from the list
+	Unblock []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=unblock,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"unblock,omitempty"`
+}
+
+func (m *BlockedAddressesProposal) Reset()      { *m = BlockedAddressesProposal{} }
+func (*BlockedAddressesProposal) ProtoMessage() {}
+func (*BlockedAddressesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_717c78e54d4b5794, []int{10}
+}
+func (m *BlockedAddressesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockedAddressesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockedAddressesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockedAddressesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedAddressesProposal.Merge(m, src)
+}
+func (m *BlockedAddressesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockedAddressesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedAddressesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockedAddressesProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.SendEnabled")
@@ -397,49 +623,68 @@ func init() {
 	proto.RegisterType((*Output)(nil), "cosmos.bank.Output")
 	proto.RegisterType((*MsgMultiSend)(nil), "cosmos.bank.MsgMultiSend")
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.Supply")
+	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.DenomUnit")
+	proto.RegisterType((*Metadata)(nil), "cosmos.bank.Metadata")
+	proto.RegisterType((*SendEnabledProposal)(nil), "cosmos.bank.SendEnabledProposal")
+	proto.RegisterType((*BlockedAddressesProposal)(nil), "cosmos.bank.BlockedAddressesProposal")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/bank.proto", fileDescriptor_717c78e54d4b5794) }
 
 var fileDescriptor_717c78e54d4b5794 = []byte{
-	// 583 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x3d, 0x6f, 0xd3, 0x4e,
-	0x18, 0xf7, 0xa5, 0xad, 0xd3, 0x5e, 0xb2, 0xfc, 0x2f, 0xd1, 0x5f, 0xa6, 0x12, 0x76, 0xb0, 0x84,
-	0x94, 0x22, 0xe2, 0x14, 0x2a, 0x96, 0x6c, 0x75, 0x55, 0xa0, 0x42, 0x11, 0xc8, 0x45, 0x0c, 0x20,
-	0x11, 0x39, 0xf1, 0x35, 0x44, 0xb1, 0x7d, 0x56, 0xee, 0x2c, 0x35, 0xe2, 0x0b, 0x30, 0x32, 0x32,
-	0x66, 0x61, 0x61, 0x02, 0x89, 0x8d, 0x2f, 0x50, 0x89, 0xa5, 0x62, 0x62, 0x32, 0x28, 0x59, 0x98,
-	0x33, 0x32, 0x21, 0xdf, 0x9d, 0xa9, 0x2d, 0x21, 0x54, 0x5e, 0x16, 0x96, 0x28, 0xf7, 0x3c, 0xbf,
-	0xb7, 0x7b, 0xce, 0x77, 0xf0, 0xff, 0x01, 0xa1, 0x01, 0xa1, 0xed, 0xbe, 0x1b, 0x8e, 0xf9, 0x8f,
-	0x15, 0x4d, 0x08, 0x23, 0xa8, 0x22, 0xea, 0x56, 0x5a, 0xda, 0xac, 0x0f, 0xc9, 0x90, 0xf0, 0x7a,
-	0x3b, 0xfd, 0x27, 0x20, 0x9b, 0x17, 0x04, 0xa4, 0x27, 0x1a, 0x12, 0x2f, 0x5a, 0x35, 0xa9, 0x9a,
-	0x2f, 0x9a, 0xef, 0x01, 0x54, 0xef, 0xb9, 0x13, 0x37, 0xa0, 0xe8, 0x31, 0xac, 0x52, 0x1c, 0x7a,
-	0x3d, 0x1c, 0xba, 0x7d, 0x1f, 0x7b, 0x1a, 0x68, 0xac, 0x34, 0x2b, 0xd7, 0x35, 0x2b, 0x67, 0x6a,
-	0x1d, 0xe2, 0xd0, 0xdb, 0x17, 0x7d, 0xfb, 0xd2, 0x32, 0x31, 0x2e, 0x4e, 0xdd, 0xc0, 0xef, 0x98,
-	0x79, 0xde, 0x55, 0x12, 0x8c, 0x18, 0x0e, 0x22, 0x36, 0x35, 0x9d, 0x0a, 0x3d, 0xc3, 0xa3, 0x47,
-	0xb0, 0xee, 0xe1, 0x23, 0x37, 0xf6, 0x59, 0xaf, 0xe0, 0x53, 0x6a, 0x80, 0xe6, 0xba, 0xbd, 0xb5,
-	0x4c, 0x8c, 0xcb, 0x42, 0xed, 0x47, 0xa8, 0xbc, 0x2a, 0x92, 0x80, 0x5c, 0x98, 0xce, 0xea, 0x8b,
-	0x99, 0xa1, 0x98, 0xb7, 0x60, 0x25, 0x57, 0x44, 0x75, 0xb8, 0xe6, 0xe1, 0x90, 0x04, 0x1a, 0x68,
-	0x80, 0xe6, 0x86, 0x23, 0x16, 0x48, 0x83, 0xe5, 0x82, 0xb5, 0x93, 0x2d, 0x3b, 0xeb, 0xa9, 0xc8,
-	0x97, 0x99, 0x01, 0xcc, 0x77, 0x25, 0x58, 0xee, 0xd2, 0x61, 0x2a, 0x86, 0xc6, 0xb0, 0x7a, 0x34,
-	0x21, 0x41, 0xcf, 0xf5, 0xbc, 0x09, 0xa6, 0x94, 0x8b, 0x55, 0xed, 0xdb, 0xcb, 0xc4, 0xa8, 0x89,
-	0xbc, 0xf9, 0xae, 0xf9, 0x35, 0x31, 0x5a, 0xc3, 0x11, 0x7b, 0x12, 0xf7, 0xad, 0x01, 0x09, 0xda,
-	0x85, 0x99, 0xb7, 0xa8, 0x37, 0x6e, 0xb3, 0x69, 0x84, 0xa9, 0xb5, 0x3b, 0x18, 0xec, 0x0a, 0x86,
-	0x53, 0x49, 0xf9, 0x72, 0x81, 0x30, 0x84, 0x8c, 0x7c, 0xb7, 0x2a, 0x71, 0xab, 0x9b, 0xcb, 0xc4,
-	0xf8, 0x4f, 0x58, 0x9d, 0xf5, 0x7e, 0xc3, 0x68, 0x83, 0x91, 0xcc, 0xe6, 0x01, 0x54, 0xdd, 0x80,
-	0xc4, 0x21, 0xd3, 0x56, 0xf8, 0x29, 0x57, 0xb3, 0x53, 0xde, 0x23, 0xa3, 0xd0, 0xde, 0x3e, 0x49,
-	0x0c, 0xe5, 0xd5, 0x27, 0xa3, 0x79, 0x0e, 0xfd, 0x94, 0x40, 0x1d, 0xa9, 0xd6, 0x59, 0xe5, 0xd3,
-	0x7b, 0x0d, 0xe0, 0xda, 0x41, 0x18, 0xc5, 0x0c, 0xdd, 0x81, 0xe5, 0xe2, 0xd8, 0xae, 0xfd, 0x7a,
-	0xec, 0x4c, 0x01, 0xdd, 0x87, 0x6b, 0x83, 0xd4, 0x4d, 0x2b, 0xfd, 0x95, 0xcc, 0x42, 0x4c, 0x46,
-	0x7e, 0x03, 0xa0, 0x7a, 0x37, 0x66, 0xff, 0x54, 0xe6, 0xa7, 0xb0, 0xda, 0xa5, 0xc3, 0x6e, 0xec,
-	0xb3, 0x11, 0xff, 0x50, 0xb7, 0xa1, 0x3a, 0x4a, 0xa7, 0x4e, 0xe5, 0xd5, 0x45, 0x85, 0xab, 0xcb,
-	0x0f, 0xc4, 0x5e, 0x4d, 0x2d, 0x1d, 0x89, 0x43, 0x3b, 0xb0, 0x4c, 0xf8, 0xa6, 0xb3, 0x7c, 0xb5,
-	0x02, 0x45, 0x0c, 0x44, 0x72, 0x32, 0xa4, 0x34, 0x7f, 0x09, 0xa0, 0x7a, 0x18, 0x47, 0x91, 0x3f,
-	0x4d, 0xf7, 0xc8, 0x08, 0x73, 0x7d, 0x69, 0xfb, 0xc7, 0x7b, 0xe4, 0x62, 0x9d, 0xfd, 0x67, 0x33,
-	0x43, 0xc9, 0x2e, 0xe4, 0x87, 0xb7, 0xad, 0x1b, 0x57, 0x7e, 0xaa, 0x70, 0x2c, 0x5e, 0x4b, 0x7c,
-	0x1c, 0x91, 0x09, 0xc3, 0x9e, 0x25, 0xb2, 0x1d, 0xd8, 0x7b, 0x27, 0x73, 0x1d, 0x9c, 0xce, 0x75,
-	0xf0, 0x79, 0xae, 0x83, 0xe7, 0x0b, 0x5d, 0x39, 0x5d, 0xe8, 0xca, 0xc7, 0x85, 0xae, 0x3c, 0xdc,
-	0x3a, 0x8f, 0x1e, 0x0f, 0xd6, 0x57, 0xf9, 0x63, 0xb9, 0xf3, 0x2d, 0x00, 0x00, 0xff, 0xff, 0xba,
-	0x32, 0x90, 0x68, 0x99, 0x05, 0x00, 0x00,
+	// 827 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4d, 0x6f, 0xf3, 0x44,
+	0x10, 0xce, 0xe6, 0x3b, 0x9b, 0xbc, 0x42, 0x6c, 0xaa, 0xca, 0x04, 0x11, 0x07, 0x4b, 0x48, 0x79,
+	0x11, 0x4d, 0x5e, 0xa8, 0xb8, 0xe4, 0x56, 0xf7, 0x8b, 0x0a, 0x45, 0x54, 0x2e, 0x5f, 0x02, 0x89,
+	0x68, 0x13, 0x6f, 0x83, 0x15, 0xdb, 0x6b, 0x79, 0xd7, 0x52, 0x23, 0xfe, 0x00, 0x47, 0x8e, 0x1c,
+	0x7b, 0xe1, 0xc2, 0x05, 0x90, 0xb8, 0xf1, 0x07, 0x2a, 0x71, 0xa9, 0x38, 0x71, 0x32, 0xa8, 0xbd,
+	0x70, 0x8e, 0xc4, 0x85, 0x13, 0xda, 0x5d, 0x3b, 0x75, 0x52, 0x3e, 0x4a, 0xdb, 0x0b, 0x97, 0xc8,
+	0x33, 0x3b, 0xf3, 0x3c, 0x33, 0x8f, 0x3d, 0xb3, 0x81, 0x9b, 0x13, 0xca, 0x3c, 0xca, 0xfa, 0x63,
+	0xec, 0xcf, 0xe4, 0x4f, 0x2f, 0x08, 0x29, 0xa7, 0xa8, 0xae, 0xfc, 0x3d, 0xe1, 0x6a, 0x6d, 0x4c,
+	0xe9, 0x94, 0x4a, 0x7f, 0x5f, 0x3c, 0xa9, 0x90, 0xd6, 0x0b, 0x2a, 0x64, 0xa4, 0x0e, 0x92, 0x78,
+	0x75, 0xd4, 0x4c, 0x50, 0xb3, 0x4e, 0xe3, 0x47, 0x00, 0xcb, 0xc7, 0x38, 0xc4, 0x1e, 0x43, 0x9f,
+	0xc0, 0x06, 0x23, 0xbe, 0x3d, 0x22, 0x3e, 0x1e, 0xbb, 0xc4, 0xd6, 0x40, 0xa7, 0xd0, 0xad, 0xbf,
+	0xa1, 0xf5, 0x32, 0xa4, 0xbd, 0x13, 0xe2, 0xdb, 0xfb, 0xea, 0xdc, 0x7c, 0x79, 0x11, 0xeb, 0x2f,
+	0xcd, 0xb1, 0xe7, 0x0e, 0x8c, 0x6c, 0xde, 0x6b, 0xd4, 0x73, 0x38, 0xf1, 0x02, 0x3e, 0x37, 0xac,
+	0x3a, 0xbb, 0x89, 0x47, 0x1f, 0xc3, 0x0d, 0x9b, 0x9c, 0xe2, 0xc8, 0xe5, 0xa3, 0x15, 0x9e, 0x7c,
+	0x07, 0x74, 0xab, 0xe6, 0xd3, 0x45, 0xac, 0xbf, 0xa2, 0xd0, 0xfe, 0x2a, 0x2a, 0x8b, 0x8a, 0x92,
+	0x80, 0x4c, 0x31, 0x83, 0xe2, 0x97, 0xe7, 0x7a, 0xce, 0x38, 0x84, 0xf5, 0x8c, 0x13, 0x6d, 0xc0,
+	0x92, 0x4d, 0x7c, 0xea, 0x69, 0xa0, 0x03, 0xba, 0x35, 0x4b, 0x19, 0x48, 0x83, 0x95, 0x15, 0x6a,
+	0x2b, 0x35, 0x07, 0x55, 0x01, 0xf2, 0xdb, 0xb9, 0x0e, 0x8c, 0x1f, 0xf2, 0xb0, 0x32, 0x64, 0x53,
+	0x01, 0x86, 0x66, 0xb0, 0x71, 0x1a, 0x52, 0x6f, 0x84, 0x6d, 0x3b, 0x24, 0x8c, 0x49, 0xb0, 0x86,
+	0xf9, 0xd6, 0x22, 0xd6, 0x9b, 0xaa, 0xde, 0xec, 0xa9, 0xf1, 0x47, 0xac, 0x6f, 0x4d, 0x1d, 0xfe,
+	0x69, 0x34, 0xee, 0x4d, 0xa8, 0xd7, 0x5f, 0xd1, 0x7c, 0x8b, 0xd9, 0xb3, 0x3e, 0x9f, 0x07, 0x84,
+	0xf5, 0x76, 0x26, 0x93, 0x1d, 0x95, 0x61, 0xd5, 0x45, 0x7e, 0x62, 0x20, 0x02, 0x21, 0xa7, 0x4b,
+	0xaa, 0xbc, 0xa4, 0x3a, 0x58, 0xc4, 0xfa, 0xf3, 0x8a, 0x8a, 0xd3, 0x07, 0x10, 0xd5, 0x38, 0x4d,
+	0x69, 0xde, 0x87, 0x65, 0xec, 0xd1, 0xc8, 0xe7, 0x5a, 0x41, 0xbe, 0xe5, 0x46, 0xfa, 0x96, 0x77,
+	0xa9, 0xe3, 0x9b, 0xcf, 0x2e, 0x62, 0x3d, 0xf7, 0xf5, 0x2f, 0x7a, 0xf7, 0x0e, 0xf8, 0x22, 0x81,
+	0x59, 0x09, 0xda, 0xa0, 0x28, 0xd5, 0xfb, 0x16, 0xc0, 0xd2, 0x91, 0x1f, 0x44, 0x1c, 0xbd, 0x0d,
+	0x2b, 0xab, 0xb2, 0xbd, 0xfe, 0xdf, 0xcb, 0x4e, 0x11, 0xd0, 0xbb, 0xb0, 0x34, 0x11, 0x6c, 0x5a,
+	0xfe, 0x51, 0x6a, 0x56, 0x60, 0x49, 0xc9, 0xdf, 0x01, 0x58, 0x7e, 0x27, 0xe2, 0xff, 0xab, 0x9a,
+	0x3f, 0x83, 0x8d, 0x21, 0x9b, 0x0e, 0x23, 0x97, 0x3b, 0xf2, 0x43, 0x7d, 0x06, 0xcb, 0x8e, 0x50,
+	0x9d, 0x25, 0xa3, 0x8b, 0x56, 0x46, 0x57, 0xbe, 0x10, 0xb3, 0x28, 0x28, 0xad, 0x24, 0x0e, 0x6d,
+	0xc3, 0x0a, 0x95, 0x4d, 0xa7, 0xf5, 0x35, 0x57, 0x52, 0x94, 0x20, 0x49, 0x4e, 0x1a, 0x99, 0x90,
+	0x7f, 0x05, 0x60, 0xf9, 0x24, 0x0a, 0x02, 0x77, 0x2e, 0x7a, 0xe4, 0x94, 0x63, 0x57, 0x03, 0x8f,
+	0xd3, 0xa3, 0x04, 0x1b, 0xec, 0x7f, 0x7e, 0xae, 0xe7, 0xd2, 0x81, 0xfc, 0xe9, 0xfb, 0xad, 0x37,
+	0x5f, 0xfd, 0x47, 0x84, 0x33, 0xb5, 0x2d, 0xc9, 0x59, 0x40, 0x43, 0x4e, 0xec, 0x9e, 0xaa, 0xed,
+	0xc8, 0xf8, 0x00, 0xd6, 0xf6, 0xc4, 0xd8, 0xbf, 0xe7, 0x3b, 0xfc, 0x6f, 0x16, 0x42, 0x0b, 0x56,
+	0x45, 0x9a, 0x4f, 0x7c, 0x2e, 0x27, 0xee, 0x89, 0xb5, 0xb4, 0xc5, 0xb2, 0xc0, 0xae, 0x83, 0x19,
+	0x61, 0x72, 0x52, 0x6a, 0x56, 0x6a, 0x1a, 0xdf, 0x00, 0x58, 0x1d, 0x12, 0x8e, 0x6d, 0xcc, 0x31,
+	0xea, 0xc0, 0xba, 0x4d, 0xd8, 0x24, 0x74, 0x02, 0xee, 0x50, 0x3f, 0x81, 0xcf, 0xba, 0xd0, 0x89,
+	0x88, 0xf0, 0xa9, 0x37, 0x8a, 0x7c, 0x67, 0x29, 0xf7, 0xe6, 0x8a, 0xdc, 0xcb, 0x3a, 0xcd, 0x96,
+	0x10, 0x6d, 0x11, 0xeb, 0x28, 0x5d, 0x88, 0xcb, 0x44, 0xc3, 0x82, 0x76, 0x1a, 0xc6, 0x10, 0x82,
+	0xc5, 0x31, 0x66, 0x44, 0x2b, 0x48, 0x3e, 0xf9, 0x2c, 0x2a, 0xb6, 0x1d, 0x16, 0xb8, 0x78, 0xae,
+	0x15, 0xa5, 0x3b, 0x35, 0x8d, 0xdf, 0x01, 0x6c, 0x66, 0xd6, 0xe3, 0x71, 0x48, 0x03, 0xca, 0xb0,
+	0x2b, 0x54, 0xe1, 0x0e, 0x77, 0x49, 0xaa, 0x8a, 0x34, 0xd6, 0x5b, 0xca, 0xdf, 0x6e, 0xe9, 0xc3,
+	0xb5, 0x0b, 0xa3, 0xf0, 0x2f, 0x17, 0xc6, 0x8b, 0x49, 0x57, 0xcd, 0xdb, 0x97, 0xc6, 0xda, 0x55,
+	0x61, 0xc2, 0xe7, 0x22, 0x46, 0x46, 0xe9, 0x45, 0x70, 0x4a, 0x43, 0xad, 0x28, 0xd4, 0x37, 0x5b,
+	0x8b, 0x58, 0xdf, 0x54, 0xe9, 0x6b, 0x01, 0x86, 0xf5, 0x24, 0x62, 0x64, 0x4f, 0x39, 0x0e, 0x68,
+	0x38, 0xa8, 0xa6, 0xdf, 0x8f, 0xb1, 0x00, 0x50, 0x33, 0x5d, 0x3a, 0x99, 0x11, 0x3b, 0x99, 0x4f,
+	0xc2, 0x1e, 0xdc, 0xfc, 0x21, 0x2c, 0x8d, 0x05, 0xa6, 0xec, 0xfa, 0x5e, 0x3b, 0x42, 0xe5, 0x8b,
+	0x75, 0x13, 0xf9, 0x0a, 0xaa, 0x78, 0x5f, 0xa8, 0x14, 0xe1, 0xa6, 0x69, 0x73, 0xf7, 0xe2, 0xaa,
+	0x0d, 0x2e, 0xaf, 0xda, 0xe0, 0xd7, 0xab, 0x36, 0xf8, 0xe2, 0xba, 0x9d, 0xbb, 0xbc, 0x6e, 0xe7,
+	0x7e, 0xbe, 0x6e, 0xe7, 0x3e, 0x7a, 0x7a, 0x97, 0x39, 0x92, 0x14, 0xe3, 0xb2, 0xfc, 0x93, 0xb0,
+	0xfd, 0xe7, 0x00, 0x2a, 0x02, 0x23, 0xea, 0x91, 0x08, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -948,106 +1193,323 @@ func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBank(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DenomUnit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DenomUnit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SendEnabled) > 0 {
-		for _, e := range m.SendEnabled {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aliases[iNdEx])
+			copy(dAtA[i:], m.Aliases[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.Aliases[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.DefaultSendEnabled {
-		n += 2
+	if m.Exponent != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SendEnabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	if m.Enabled {
-		n += 2
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
+	if len(m.DenomUnits) > 0 {
+		for iNdEx := len(m.DenomUnits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Input) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
-		}
+func (m *SendEnabledProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Output) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SendEnabledProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendEnabledProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
+	if len(m.UseDefaultFor) > 0 {
+		for iNdEx := len(m.UseDefaultFor) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UseDefaultFor[iNdEx])
+			copy(dAtA[i:], m.UseDefaultFor[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.UseDefaultFor[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockedAddressesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockedAddressesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockedAddressesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unblock) > 0 {
+		for iNdEx := len(m.Unblock) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Unblock[iNdEx])
+			copy(dAtA[i:], m.Unblock[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.Unblock[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Block) > 0 {
+		for iNdEx := len(m.Block) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Block[iNdEx])
+			copy(dAtA[i:], m.Block[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.Block[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBank(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.DefaultSendEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *SendEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Output) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
 			n += 1 + l + sovBank(uint64(l))
 		}
 	}
@@ -1090,6 +1552,113 @@ func (m *Supply) Size() (n int) {
 	return n
 }
 
+func (m *DenomUnit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovBank(uint64(m.Exponent))
+	}
+	if len(m.Aliases) > 0 {
+		for _, s := range m.Aliases {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.DenomUnits) > 0 {
+		for _, e := range m.DenomUnits {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *SendEnabledProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if len(m.UseDefaultFor) > 0 {
+		for _, s := range m.UseDefaultFor {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockedAddressesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Block) > 0 {
+		for _, b := range m.Block {
+			l = len(b)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if len(m.Unblock) > 0 {
+		for _, b := range m.Unblock {
+			l = len(b)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1913,6 +2482,689 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomUnit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomUnits = append(m.DenomUnits, DenomUnit{})
+			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendEnabledProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendEnabledProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendEnabledProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefaultFor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UseDefaultFor = append(m.UseDefaultFor, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockedAddressesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockedAddressesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockedAddressesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Block = append(m.Block, make([]byte, postIndex-iNdEx))
+			copy(m.Block[len(m.Block)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unblock", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unblock = append(m.Unblock, make([]byte, postIndex-iNdEx))
+			copy(m.Unblock[len(m.Unblock)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
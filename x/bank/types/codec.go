@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterCodec registers the necessary x/bank interfaces and concrete types
@@ -15,6 +16,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&Supply{}, "cosmos-sdk/Supply", nil)
 	cdc.RegisterConcrete(&MsgSend{}, "cosmos-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgMultiSend{}, "cosmos-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(&SendEnabledProposal{}, "cosmos-sdk/SendEnabledProposal", nil)
+	cdc.RegisterConcrete(&BlockedAddressesProposal{}, "cosmos-sdk/BlockedAddressesProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -22,6 +25,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSend{},
 		&MsgMultiSend{},
 	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SendEnabledProposal{},
+		&BlockedAddressesProposal{},
+	)
 
 	registry.RegisterInterface(
 		"cosmos_sdk.bank.v1.bank",
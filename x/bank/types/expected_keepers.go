@@ -25,3 +25,9 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
 	SetModuleAccount(ctx sdk.Context, macc types.ModuleAccountI)
 }
+
+// BankHooks event hooks for account balances (noalias)
+type BankHooks interface {
+	BeforeBalanceChange(ctx sdk.Context, addr sdk.AccAddress, oldBalance, newBalance sdk.Coin) error // Must be called before a balance is changed, which is aborted on error
+	AfterBalanceChange(ctx sdk.Context, addr sdk.AccAddress, oldBalance, newBalance sdk.Coin)        // Must be called after a balance is changed
+}
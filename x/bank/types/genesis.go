@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -17,6 +18,14 @@ type GenesisState struct {
 	Params   Params    `json:"params" yaml:"params"`
 	Balances []Balance `json:"balances" yaml:"balances"`
 	Supply   sdk.Coins `json:"supply" yaml:"supply"`
+
+	DenomMetadata []Metadata `json:"denom_metadata" yaml:"denom_metadata"`
+
+	// SendEnabled are the send enabled statuses set for denominations, which
+	// take precedence over the params, and BlockedAddresses the addresses
+	// blocked by governance.
+	SendEnabled      []SendEnabled    `json:"send_enabled" yaml:"send_enabled"`
+	BlockedAddresses []sdk.AccAddress `json:"blocked_addresses" yaml:"blocked_addresses"`
 }
 
 // Balance defines an account address and balance pair used in the bank module's
@@ -56,21 +65,68 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	bases := make(map[string]bool, len(data.DenomMetadata))
+	for _, metadata := range data.DenomMetadata {
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+
+		if bases[metadata.Base] {
+			return fmt.Errorf("duplicate metadata of denom %s", metadata.Base)
+		}
+
+		bases[metadata.Base] = true
+	}
+
+	denoms := make(map[string]bool, len(data.SendEnabled))
+	for _, se := range data.SendEnabled {
+		if err := sdk.ValidateDenom(se.Denom); err != nil {
+			return fmt.Errorf("invalid send enabled denom: %w", err)
+		}
+
+		if denoms[se.Denom] {
+			return fmt.Errorf("duplicate send enabled status of denom %s", se.Denom)
+		}
+
+		denoms[se.Denom] = true
+	}
+
+	addrs := make(map[string]bool, len(data.BlockedAddresses))
+	for _, addr := range data.BlockedAddresses {
+		if addr.Empty() {
+			return fmt.Errorf("empty blocked address")
+		}
+
+		if addrs[addr.String()] {
+			return fmt.Errorf("duplicate blocked address %s", addr)
+		}
+
+		addrs[addr.String()] = true
+	}
+
 	return NewSupply(data.Supply).ValidateBasic()
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, balances []Balance, supply sdk.Coins) GenesisState {
+func NewGenesisState(
+	params Params, balances []Balance, supply sdk.Coins, denomMetadata []Metadata,
+	sendEnabled []SendEnabled, blockedAddrs []sdk.AccAddress,
+) GenesisState {
 	return GenesisState{
-		Params:   params,
-		Balances: balances,
-		Supply:   supply,
+		Params:           params,
+		Balances:         balances,
+		Supply:           supply,
+		DenomMetadata:    denomMetadata,
+		SendEnabled:      sendEnabled,
+		BlockedAddresses: blockedAddrs,
 	}
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams(), []Balance{}, DefaultSupply().GetTotal())
+	return NewGenesisState(
+		DefaultParams(), []Balance{}, DefaultSupply().GetTotal(), []Metadata{}, []SendEnabled{}, []sdk.AccAddress{},
+	)
 }
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
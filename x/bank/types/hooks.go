@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple bank hooks, all hook functions are run in array sequence
+type MultiBankHooks []BankHooks
+
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+// BeforeBalanceChange runs the hooks in sequence and stops at the first error.
+func (h MultiBankHooks) BeforeBalanceChange(ctx sdk.Context, addr sdk.AccAddress, oldBalance, newBalance sdk.Coin) error {
+	for i := range h {
+		if err := h[i].BeforeBalanceChange(ctx, addr, oldBalance, newBalance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiBankHooks) AfterBalanceChange(ctx sdk.Context, addr sdk.AccAddress, oldBalance, newBalance sdk.Coin) {
+	for i := range h {
+		h[i].AfterBalanceChange(ctx, addr, oldBalance, newBalance)
+	}
+}
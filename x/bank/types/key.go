@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,9 +24,71 @@ const (
 // KVStore keys
 var (
 	BalancesPrefix = []byte("balances")
-	SupplyKey      = []byte{0x00}
+	// SupplyKey is the key of the legacy supply of all the denominations, which
+	// is only read to migrate it to the supply of each denomination.
+	SupplyKey           = []byte{0x00}
+	DenomMetadataPrefix = []byte{0x01}
+	SendEnabledPrefix   = []byte{0x02}
+	BlockedAddrPrefix   = []byte{0x03}
+
+	DenomOwnersPrefix      = []byte{0x04}
+	DenomOwnersCountPrefix = []byte{0x05}
+
+	SupplyPrefix = []byte{0x06}
 )
 
+// DenomMetadataKey returns the store key of the metadata of a base denomination.
+func DenomMetadataKey(denom string) []byte {
+	return append(append([]byte{}, DenomMetadataPrefix...), denom...)
+}
+
+// SendEnabledKey returns the store key of the send enabled status of a
+// denomination.
+func SendEnabledKey(denom string) []byte {
+	return append(append([]byte{}, SendEnabledPrefix...), denom...)
+}
+
+// BlockedAddrKey returns the store key of an address blocked by governance.
+func BlockedAddrKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, BlockedAddrPrefix...), addr...)
+}
+
+// DenomOwnersStorePrefix returns the store prefix of the index of the owners
+// of a denomination. The denomination is terminated by a zero byte, which is
+// not a valid denomination character, so that the owners of a denomination
+// are not iterated over with those of the denominations it prefixes.
+func DenomOwnersStorePrefix(denom string) []byte {
+	key := append(append([]byte{}, DenomOwnersPrefix...), denom...)
+	return append(key, 0)
+}
+
+// DenomOwnerKey returns the store key indexing an owner of a denomination.
+func DenomOwnerKey(denom string, addr sdk.AccAddress) []byte {
+	return append(DenomOwnersStorePrefix(denom), addr...)
+}
+
+// SplitDenomOwnerKey returns the denomination and the address of an owner from
+// a key of the denomination owners index, without the DenomOwnersPrefix.
+func SplitDenomOwnerKey(key []byte) (string, sdk.AccAddress) {
+	i := bytes.IndexByte(key, 0)
+	if i < 0 {
+		panic(fmt.Sprintf("unexpected denomination owner key %X", key))
+	}
+
+	return string(key[:i]), sdk.AccAddress(key[i+1:])
+}
+
+// DenomOwnersCountKey returns the store key of the number of owners of a
+// denomination.
+func DenomOwnersCountKey(denom string) []byte {
+	return append(append([]byte{}, DenomOwnersCountPrefix...), denom...)
+}
+
+// SupplyOfKey returns the store key of the supply of a denomination.
+func SupplyOfKey(denom string) []byte {
+	return append(append([]byte{}, SupplyPrefix...), denom...)
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the perfix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -0,0 +1,184 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMetadata creates a new Metadata instance.
+func NewMetadata(description, base, display string, units ...DenomUnit) Metadata {
+	return Metadata{
+		Description: description,
+		DenomUnits:  units,
+		Base:        base,
+		Display:     display,
+	}
+}
+
+// NewDenomUnit creates a new DenomUnit instance.
+func NewDenomUnit(denom string, exponent uint32, aliases ...string) DenomUnit {
+	return DenomUnit{
+		Denom:    denom,
+		Exponent: exponent,
+		Aliases:  aliases,
+	}
+}
+
+// Validate performs a basic validation of the metadata. The first unit must be
+// the base denomination, with exponent 0, and the units must be sorted by
+// strictly ascending exponents of at most sdk.Precision. The names and aliases
+// of the units must be valid and unique denominations, and the display unit
+// one of the units.
+func (m Metadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return fmt.Errorf("invalid metadata base denom: %w", err)
+	}
+
+	if len(m.DenomUnits) == 0 || m.DenomUnits[0].Denom != m.Base || m.DenomUnits[0].Exponent != 0 {
+		return fmt.Errorf("the first unit of denom %s must be the base denom with exponent 0", m.Base)
+	}
+
+	names := make(map[string]bool)
+
+	for i, unit := range m.DenomUnits {
+		if i > 0 && unit.Exponent <= m.DenomUnits[i-1].Exponent {
+			return fmt.Errorf("units of denom %s are not sorted by strictly ascending exponents", m.Base)
+		}
+
+		if unit.Exponent > sdk.Precision {
+			return fmt.Errorf("unit %s of denom %s has exponent %d greater than %d", unit.Denom, m.Base, unit.Exponent, sdk.Precision)
+		}
+
+		for _, name := range append([]string{unit.Denom}, unit.Aliases...) {
+			if err := sdk.ValidateDenom(name); err != nil {
+				return fmt.Errorf("invalid unit of denom %s: %w", m.Base, err)
+			}
+
+			if names[name] {
+				return fmt.Errorf("duplicate unit %s of denom %s", name, m.Base)
+			}
+
+			names[name] = true
+		}
+	}
+
+	if _, ok := m.Unit(m.Display); !ok {
+		return fmt.Errorf("display unit %s of denom %s is not one of its units", m.Display, m.Base)
+	}
+
+	return nil
+}
+
+// Unit returns the unit of the given name or alias.
+func (m Metadata) Unit(name string) (DenomUnit, bool) {
+	for _, unit := range m.DenomUnits {
+		if unit.Denom == name {
+			return unit, true
+		}
+
+		for _, alias := range unit.Aliases {
+			if alias == name {
+				return unit, true
+			}
+		}
+	}
+
+	return DenomUnit{}, false
+}
+
+// DisplayCoin returns the given amount of the base denomination in the display
+// unit.
+func (m Metadata) DisplayCoin(amount sdk.Int) sdk.DecCoin {
+	unit, _ := m.Unit(m.Display)
+	return sdk.NewDecCoinFromDec(unit.Denom, sdk.NewDecFromIntWithPrec(amount, int64(unit.Exponent)))
+}
+
+// BaseCoin returns the coin of the base denomination of the given amount of the
+// unit of the given name or alias. An error is returned if the unit does not
+// exist or the amount is not a whole amount of the base denomination.
+func (m Metadata) BaseCoin(amount sdk.Dec, unitName string) (sdk.Coin, error) {
+	unit, ok := m.Unit(unitName)
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("%s is not a unit of denom %s", unitName, m.Base)
+	}
+
+	baseAmount := amount.MulInt(sdk.NewIntWithDecimal(1, int(unit.Exponent)))
+	if !baseAmount.IsInteger() {
+		return sdk.Coin{}, fmt.Errorf("%s%s is not a whole amount of %s", amount, unitName, m.Base)
+	}
+
+	return sdk.NewCoin(m.Base, baseAmount.TruncateInt()), nil
+}
+
+// FormatCoins returns the given coins in the display units of their
+// denominations, or in their base denominations if they have no metadata.
+func FormatCoins(coins sdk.Coins, metadatas []Metadata) sdk.DecCoins {
+	byBase := make(map[string]Metadata, len(metadatas))
+	for _, m := range metadatas {
+		byBase[m.Base] = m
+	}
+
+	formatted := make(sdk.DecCoins, 0, len(coins))
+
+	for _, coin := range coins {
+		if m, ok := byBase[coin.Denom]; ok {
+			formatted = append(formatted, m.DisplayCoin(coin.Amount))
+		} else {
+			formatted = append(formatted, sdk.NewDecCoinFromCoin(coin))
+		}
+	}
+
+	return formatted.Sort()
+}
+
+// ParseCoins parses a comma separated list of amounts of any unit of the given
+// denominations, e.g. "1.5atom,10stake", into coins of their base denominations.
+// Amounts of denominations without metadata must be whole amounts of their
+// base denominations.
+func ParseCoins(coinsStr string, metadatas []Metadata) (sdk.Coins, error) {
+	coinsStr = strings.TrimSpace(coinsStr)
+	if len(coinsStr) == 0 {
+		return nil, nil
+	}
+
+	var coins sdk.Coins
+
+	for _, coinStr := range strings.Split(coinsStr, ",") {
+		coinStr = strings.TrimSpace(coinStr)
+
+		i := strings.IndexFunc(coinStr, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid coin expression: %s", coinStr)
+		}
+
+		amount, err := sdk.NewDecFromStr(coinStr[:i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid coin expression %s: %w", coinStr, err)
+		}
+
+		unitName := strings.TrimSpace(coinStr[i:])
+		if err := sdk.ValidateDenom(unitName); err != nil {
+			return nil, err
+		}
+
+		m := NewMetadata("", unitName, unitName, NewDenomUnit(unitName, 0))
+
+		for _, metadata := range metadatas {
+			if _, ok := metadata.Unit(unitName); ok {
+				m = metadata
+				break
+			}
+		}
+
+		coin, err := m.BaseCoin(amount, unitName)
+		if err != nil {
+			return nil, err
+		}
+
+		coins = coins.Add(coin)
+	}
+
+	return coins, nil
+}
@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func atomMetadata() types.Metadata {
+	return types.NewMetadata(
+		"The native staking token of the Cosmos Hub.", "uatom", "atom",
+		types.NewDenomUnit("uatom", 0, "microatom"),
+		types.NewDenomUnit("matom", 3, "milliatom"),
+		types.NewDenomUnit("atom", 6),
+	)
+}
+
+func TestMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata func(m *types.Metadata)
+		expErr   bool
+	}{
+		{"valid", func(m *types.Metadata) {}, false},
+		{"invalid base", func(m *types.Metadata) { m.Base = "A" }, true},
+		{"no units", func(m *types.Metadata) { m.DenomUnits = nil }, true},
+		{"first unit not base", func(m *types.Metadata) { m.DenomUnits[0].Denom = "natom" }, true},
+		{"non-zero base exponent", func(m *types.Metadata) { m.DenomUnits[0].Exponent = 1 }, true},
+		{"unsorted exponents", func(m *types.Metadata) { m.DenomUnits[1].Exponent = 6 }, true},
+		{"exponent too large", func(m *types.Metadata) { m.DenomUnits[2].Exponent = 19 }, true},
+		{"invalid alias", func(m *types.Metadata) { m.DenomUnits[1].Aliases = []string{"Milliatom"} }, true},
+		{"duplicate alias", func(m *types.Metadata) { m.DenomUnits[2].Aliases = []string{"milliatom"} }, true},
+		{"unknown display unit", func(m *types.Metadata) { m.Display = "katom" }, true},
+		{"display alias", func(m *types.Metadata) { m.Display = "milliatom" }, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			m := atomMetadata()
+			tc.metadata(&m)
+
+			if tc.expErr {
+				require.Error(t, m.Validate())
+			} else {
+				require.NoError(t, m.Validate())
+			}
+		})
+	}
+}
+
+func TestFormatCoins(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000), sdk.NewInt64Coin("stake", 10))
+
+	formatted := types.FormatCoins(coins, []types.Metadata{atomMetadata()})
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(15, 1)),
+		sdk.NewInt64DecCoin("stake", 10),
+	}, formatted)
+}
+
+func TestParseCoins(t *testing.T) {
+	metadatas := []types.Metadata{atomMetadata()}
+
+	testCases := []struct {
+		input    string
+		expCoins sdk.Coins
+		expErr   bool
+	}{
+		{"", nil, false},
+		{"1.5atom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)), false},
+		{"2milliatom,10stake", sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000), sdk.NewInt64Coin("stake", 10)), false},
+		{"1atom, 5uatom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000005)), false},
+		{"0.0000001atom", nil, true},
+		{"1.5stake", nil, true},
+		{"atom", nil, true},
+		{"1.5Atom", nil, true},
+	}
+
+	for _, tc := range testCases {
+		coins, err := types.ParseCoins(tc.input, metadatas)
+		if tc.expErr {
+			require.Error(t, err, tc.input)
+			continue
+		}
+
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.expCoins, coins, tc.input)
+	}
+}
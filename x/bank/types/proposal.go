@@ -0,0 +1,176 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSendEnabled defines the type for a SendEnabledProposal
+	ProposalTypeSendEnabled = "SendEnabled"
+	// ProposalTypeBlockedAddresses defines the type for a BlockedAddressesProposal
+	ProposalTypeBlockedAddresses = "BlockedAddresses"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &SendEnabledProposal{}
+	_ govtypes.Content = &BlockedAddressesProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSendEnabled)
+	govtypes.RegisterProposalTypeCodec(&SendEnabledProposal{}, "cosmos-sdk/SendEnabledProposal")
+	govtypes.RegisterProposalType(ProposalTypeBlockedAddresses)
+	govtypes.RegisterProposalTypeCodec(&BlockedAddressesProposal{}, "cosmos-sdk/BlockedAddressesProposal")
+}
+
+// NewSendEnabledProposal creates a new send enabled proposal.
+func NewSendEnabledProposal(title, description string, sendEnabled []SendEnabled, useDefaultFor []string) *SendEnabledProposal {
+	return &SendEnabledProposal{title, description, sendEnabled, useDefaultFor}
+}
+
+// GetTitle returns the title of a send enabled proposal.
+func (sep *SendEnabledProposal) GetTitle() string { return sep.Title }
+
+// GetDescription returns the description of a send enabled proposal.
+func (sep *SendEnabledProposal) GetDescription() string { return sep.Description }
+
+// ProposalRoute returns the routing key of a send enabled proposal.
+func (sep *SendEnabledProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a send enabled proposal.
+func (sep *SendEnabledProposal) ProposalType() string { return ProposalTypeSendEnabled }
+
+// ValidateBasic runs basic stateless validity checks. Each denomination must be
+// valid and appear at most once in the proposal.
+func (sep *SendEnabledProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(sep)
+	if err != nil {
+		return err
+	}
+
+	if len(sep.SendEnabled) == 0 && len(sep.UseDefaultFor) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposal, "no send enabled status to set or remove")
+	}
+
+	denoms := make(map[string]bool)
+
+	for _, se := range sep.SendEnabled {
+		if err := validateProposalDenom(denoms, se.Denom); err != nil {
+			return err
+		}
+	}
+
+	for _, denom := range sep.UseDefaultFor {
+		if err := validateProposalDenom(denoms, denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateProposalDenom(denoms map[string]bool, denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidProposal, err.Error())
+	}
+
+	if denoms[denom] {
+		return sdkerrors.Wrapf(ErrInvalidProposal, "duplicate denom %s", denom)
+	}
+
+	denoms[denom] = true
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (sep SendEnabledProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Send Enabled Proposal:
+  Title:       %s
+  Description: %s
+  Send Enabled:
+`, sep.Title, sep.Description))
+
+	for _, se := range sep.SendEnabled {
+		b.WriteString(fmt.Sprintf("    %s: %t\n", se.Denom, se.Enabled))
+	}
+
+	b.WriteString(fmt.Sprintf("  Use Default For: %s\n", strings.Join(sep.UseDefaultFor, ", ")))
+
+	return b.String()
+}
+
+// NewBlockedAddressesProposal creates a new blocked addresses proposal.
+func NewBlockedAddressesProposal(title, description string, block, unblock []sdk.AccAddress) *BlockedAddressesProposal {
+	return &BlockedAddressesProposal{title, description, block, unblock}
+}
+
+// GetTitle returns the title of a blocked addresses proposal.
+func (bap *BlockedAddressesProposal) GetTitle() string { return bap.Title }
+
+// GetDescription returns the description of a blocked addresses proposal.
+func (bap *BlockedAddressesProposal) GetDescription() string { return bap.Description }
+
+// ProposalRoute returns the routing key of a blocked addresses proposal.
+func (bap *BlockedAddressesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a blocked addresses proposal.
+func (bap *BlockedAddressesProposal) ProposalType() string { return ProposalTypeBlockedAddresses }
+
+// ValidateBasic runs basic stateless validity checks. Each address must be
+// non-empty and appear at most once in the proposal.
+func (bap *BlockedAddressesProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(bap)
+	if err != nil {
+		return err
+	}
+
+	if len(bap.Block) == 0 && len(bap.Unblock) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposal, "no address to block or unblock")
+	}
+
+	addrs := make(map[string]bool)
+
+	for _, addr := range append(append([]sdk.AccAddress{}, bap.Block...), bap.Unblock...) {
+		if addr.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty address")
+		}
+
+		if addrs[addr.String()] {
+			return sdkerrors.Wrapf(ErrInvalidProposal, "duplicate address %s", addr)
+		}
+
+		addrs[addr.String()] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (bap BlockedAddressesProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Blocked Addresses Proposal:
+  Title:       %s
+  Description: %s
+  Block:
+`, bap.Title, bap.Description))
+
+	for _, addr := range bap.Block {
+		b.WriteString(fmt.Sprintf("    %s\n", addr))
+	}
+
+	b.WriteString("  Unblock:\n")
+
+	for _, addr := range bap.Unblock {
+		b.WriteString(fmt.Sprintf("    %s\n", addr))
+	}
+
+	return b.String()
+}
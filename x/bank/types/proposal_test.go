@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSendEnabledProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		sendEnabled   []types.SendEnabled
+		useDefaultFor []string
+		expPass       bool
+	}{
+		{"valid", []types.SendEnabled{{Denom: "foo", Enabled: false}}, []string{"bar"}, true},
+		{"empty", nil, nil, false},
+		{"invalid denom", []types.SendEnabled{{Denom: "f", Enabled: false}}, nil, false},
+		{"duplicate denom", []types.SendEnabled{{Denom: "foo", Enabled: false}}, []string{"foo"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := types.NewSendEnabledProposal("title", "description", tc.sendEnabled, tc.useDefaultFor).ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+
+	require.Error(t, types.NewSendEnabledProposal("", "description", nil, []string{"foo"}).ValidateBasic())
+}
+
+func TestBlockedAddressesProposalValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+
+	testCases := []struct {
+		name    string
+		block   []sdk.AccAddress
+		unblock []sdk.AccAddress
+		expPass bool
+	}{
+		{"valid", []sdk.AccAddress{addr1}, []sdk.AccAddress{addr2}, true},
+		{"empty", nil, nil, false},
+		{"empty address", []sdk.AccAddress{{}}, nil, false},
+		{"duplicate address", []sdk.AccAddress{addr1}, []sdk.AccAddress{addr1}, false},
+	}
+
+	for _, tc := range testCases {
+		err := types.NewBlockedAddressesProposal("title", "description", tc.block, tc.unblock).ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
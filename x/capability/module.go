@@ -106,6 +106,8 @@ func (am AppModule) NewQuerierHandler() sdk.Querier { return nil }
 
 func (am AppModule) RegisterQueryService(grpc.Server) {}
 
+func (am AppModule) RegisterMsgService(grpc.Server) {}
+
 // RegisterInvariants registers the capability module's invariants.
 func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
@@ -111,6 +111,8 @@ func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
 
 func (am AppModule) RegisterQueryService(grpc.Server) {}
 
+func (am AppModule) RegisterMsgService(grpc.Server) {}
+
 // InitGenesis performs genesis initialization for the crisis module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
@@ -138,6 +138,8 @@ func (am AppModule) RegisterQueryService(server grpc.Server) {
 	types.RegisterQueryServer(server, am.keeper)
 }
 
+func (am AppModule) RegisterMsgService(grpc.Server) {}
+
 // InitGenesis performs genesis initialization for the distribution module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
@@ -145,6 +145,8 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 
 func (am AppModule) RegisterQueryService(grpc.Server) {}
 
+func (am AppModule) RegisterMsgService(grpc.Server) {}
+
 // RegisterInvariants registers the evidence module's invariants.
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
 
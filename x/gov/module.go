@@ -152,6 +152,8 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 
 func (am AppModule) RegisterQueryService(grpc.Server) {}
 
+func (am AppModule) RegisterMsgService(grpc.Server) {}
+
 // InitGenesis performs genesis initialization for the gov module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
@@ -129,6 +129,8 @@ func (am AppModule) RegisterQueryService(server grpc.Server) {
 	types.RegisterQueryService(server, am.keeper)
 }
 
+func (am AppModule) RegisterMsgService(grpc.Server) {}
+
 // InitGenesis performs genesis initialization for the ibc module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, bz json.RawMessage) []abci.ValidatorUpdate {
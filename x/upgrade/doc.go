@@ -89,6 +89,28 @@ Here is a sample code to set store migrations with an upgrade:
 		app.SetStoreLoader(upgrade.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
 
+Besides renaming and deleting stores, store upgrades can add new stores, split a store into another by key
+prefix, and rewrite the keys of a store under a prefix:
+
+	storeUpgrades := store.StoreUpgrades{
+		Added: []string{"baz"},
+		Split: []store.StoreSplit{{
+			OldKey:   "bar",
+			NewKey:   "baz",
+			Prefixes: [][]byte{[]byte("baz/")},
+		}},
+		Rewrites: []store.KeyRewrite{{
+			StoreKey: "bar",
+			Prefix:   []byte("v1/"),
+			Rewrite: func(key, value []byte) ([]byte, []byte, error) {
+				return append([]byte("v2/"), key[3:]...), value, nil
+			},
+		}},
+	}
+
+Before being applied, store upgrades are applied in a dry run to an in-memory copy of the data of the stores
+they involve, so that the node fails to start without modifying its state if any of them fails.
+
 Halt Behavior
 
 Before halting the ABCI state machine in the BeginBlocker method, the upgrade module will log an error
@@ -12,7 +12,7 @@ func UpgradeStoreLoader(upgradeHeight int64, storeUpgrades *store.StoreUpgrades)
 	return func(ms sdk.CommitMultiStore) error {
 		if upgradeHeight == ms.LastCommitID().Version {
 			// Check if the current commit version and upgrade height matches
-			if len(storeUpgrades.Renamed) > 0 || len(storeUpgrades.Deleted) > 0 {
+			if !storeUpgrades.IsEmpty() {
 				return ms.LoadLatestVersionAndUpgrade(storeUpgrades)
 			}
 		}